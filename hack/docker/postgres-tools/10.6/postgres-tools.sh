@@ -47,6 +47,9 @@ DB_FOLDER=${DB_FOLDER:-}
 DB_SNAPSHOT=${DB_SNAPSHOT:-}
 DB_DATA_DIR=${DB_DATA_DIR:-/var/data}
 OSM_CONFIG_FILE=/etc/osm/config
+# keys of the encryption secret of the snapshot, and the one that the backup is encrypted with
+ENCRYPTION_PATH=/etc/encryption
+ENCRYPTION_KEY_ID=${ENCRYPTION_KEY_ID:-}
 ENABLE_ANALYTICS=${ENABLE_ANALYTICS:-true}
 
 op=$1
@@ -105,9 +108,37 @@ rm -rf *
 
 function exit_on_error() {
   echo "$1"
+  { echo "$1" >/dev/termination-log; } 2>/dev/null || true
   exit 1
 }
 
+# encrypt_dump encrypts dumpfile.sql with the key $ENCRYPTION_KEY_ID of the encryption secret. The digest of the
+# key is stored along, for the operator to check the keys that the snapshot is restored with.
+function encrypt_dump() {
+  local key="$ENCRYPTION_PATH/$ENCRYPTION_KEY_ID"
+  [[ -s "$key" ]] || exit_on_error "encryption key \"$ENCRYPTION_KEY_ID\" is missing in the encryption secret"
+  export GNUPGHOME=$(mktemp -d)
+  gpg --batch --quiet --import "$key" || exit_on_error "encryption key \"$ENCRYPTION_KEY_ID\" is not a PGP private key"
+  local fingerprint
+  fingerprint=$(gpg --batch --with-colons --list-secret-keys | awk -F: '/^fpr:/ {print $10; exit}')
+  gpg --batch --quiet --trust-model always --recipient "$fingerprint" --output dumpfile.sql.gpg --encrypt dumpfile.sql ||
+    exit_on_error "failed to encrypt backup"
+  rm dumpfile.sql
+  sha256sum "$key" | cut -d ' ' -f 1 >encryption-key.sha256
+}
+
+# decrypt_dump decrypts dumpfile.sql.gpg with any key of the encryption secret, so that snapshots taken before
+# a key rotation stay readable.
+function decrypt_dump() {
+  export GNUPGHOME=$(mktemp -d)
+  for key in "$ENCRYPTION_PATH"/*; do
+    [[ -e "$key" ]] || continue
+    gpg --batch --quiet --import "$key" || exit_on_error "encryption key \"$(basename "$key")\" is not a PGP private key"
+  done
+  gpg --batch --quiet --output dumpfile.sql --decrypt dumpfile.sql.gpg ||
+    exit_on_error "failed to decrypt backup, as it is not encrypted with a key of the encryption secret"
+}
+
 # Wait for postgres to start
 # ref: http://unix.stackexchange.com/a/5279
 while ! nc "$DB_HOST" "$DB_PORT" -w 30 >/dev/null; do
@@ -118,10 +149,16 @@ done
 case "$op" in
   backup)
     PGPASSWORD="$POSTGRES_PASSWORD" pg_dumpall -U "$DB_USER" -h "$DB_HOST" "$@" >dumpfile.sql || exit_on_error "failed to take backup"
+    if [ -n "$ENCRYPTION_KEY_ID" ]; then
+      encrypt_dump
+    fi
     osm push --enable-analytics="$ENABLE_ANALYTICS" --osmconfig="$OSM_CONFIG_FILE" -c "$DB_BUCKET" "$DB_DATA_DIR" "$DB_FOLDER/$DB_SNAPSHOT" || exit_on_error "failed to push data"
     ;;
   restore)
     osm pull --enable-analytics="$ENABLE_ANALYTICS" --osmconfig="$OSM_CONFIG_FILE" -c "$DB_BUCKET" "$DB_FOLDER/$DB_SNAPSHOT" "$DB_DATA_DIR" || exit_on_error "failed to pull data"
+    if [ -e dumpfile.sql.gpg ]; then
+      decrypt_dump
+    fi
     PGPASSWORD="$POSTGRES_PASSWORD" psql -U "$DB_USER" -h "$DB_HOST" "$@" -f dumpfile.sql postgres || exit_on_error "failed to restore backup"
     ;;
   *)
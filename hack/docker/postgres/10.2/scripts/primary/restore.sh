@@ -74,11 +74,17 @@ elif [[ ${RESTORE_SWIFT_PREFIX} != "" ]]; then
   [[ -e "$CRED_PATH/ST_KEY" ]] && export ST_KEY=$(cat "$CRED_PATH/ST_KEY")
 fi
 
+# the archive is decrypted with every key of its encryption secret
+source /scripts/encryption.sh
+restore_encryption || fail "$ENCRYPTION_ERROR"
+
 PITR=${PITR:-false}
 TARGET_INCLUSIVE=${TARGET_INCLUSIVE:-true}
 TARGET_TIME=${TARGET_TIME:-}
 TARGET_TIMELINE=${TARGET_TIMELINE:-}
 TARGET_XID=${TARGET_XID:-}
+TARGET_LSN=${TARGET_LSN:-}
+TARGET_NAME=${TARGET_NAME:-}
 
 until wal-g backup-list &>/dev/null; do
   echo "waiting for archived backup..."
@@ -87,7 +93,13 @@ done
 
 echo "Fetching archived backup..."
 # fetch backup
-wal-g backup-fetch "$PGDATA" "$BACKUP_NAME"
+if ! out=$(wal-g backup-fetch "$PGDATA" "$BACKUP_NAME" 2>&1 >/dev/null); then
+  echo "$out" >&2
+  if [[ "$out" == *openpgp* ]]; then
+    fail "failed to decrypt base backup $BACKUP_NAME, as it is not encrypted with a key of the encryption secret: $(echo "$out" | tail -n 1)"
+  fi
+  fail "failed to fetch base backup $BACKUP_NAME: $(echo "$out" | tail -n 1)"
+fi
 
 # create missing folders
 mkdir -p "$PGDATA"/{pg_tblspc,pg_twophase,pg_stat,pg_commit_ts}/
@@ -110,6 +122,12 @@ if [ "$PITR" = true ]; then
   if [ ! -z "$TARGET_XID" ]; then
     echo "recovery_target_xid = '$TARGET_XID'" >>/tmp/recovery.conf
   fi
+  if [ ! -z "$TARGET_LSN" ]; then
+    echo "recovery_target_lsn = '$TARGET_LSN'" >>/tmp/recovery.conf
+  fi
+  if [ ! -z "$TARGET_NAME" ]; then
+    echo "recovery_target_name = '$TARGET_NAME'" >>/tmp/recovery.conf
+  fi
 fi
 
 echo "restore_command = 'wal-g wal-fetch %f %p'" >>/tmp/recovery.conf
@@ -120,6 +138,7 @@ touch /tmp/postgresql.conf
 echo "wal_level = replica" >>/tmp/postgresql.conf
 echo "max_wal_senders = 99" >>/tmp/postgresql.conf
 echo "wal_keep_segments = 32" >>/tmp/postgresql.conf
+echo "wal_log_hints = on" >>/tmp/postgresql.conf # required by pg_rewind to rejoin a former primary
 if [ "$STREAMING" == "synchronous" ]; then
   # setup synchronous streaming replication
   echo "synchronous_commit = remote_write" >>/tmp/postgresql.conf
@@ -128,7 +147,7 @@ fi
 
 if [ "$ARCHIVE" == "wal-g" ]; then
   # setup postgresql.conf
-  echo "archive_command = 'wal-g wal-push %p'" >>/tmp/postgresql.conf
+  echo "archive_command = '/scripts/archive-wal.sh %p'" >>/tmp/postgresql.conf
   echo "archive_timeout = 60" >>/tmp/postgresql.conf
   echo "archive_mode = always" >>/tmp/postgresql.conf
 fi
@@ -0,0 +1,63 @@
+#!/usr/bin/env bash
+
+# Copyright The KubeDB Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+set -e
+
+# Upgrades the data directory to the major version of this image with pg_upgrade. The installation of the old
+# version is expected in $OLD_INSTALLATION. The old data directory is kept as $PV/data.rollback until the next
+# upgrade, so that the upgrade can be rolled back.
+
+OLD_INSTALLATION=${OLD_INSTALLATION:-/var/upgrade/old}
+UPGRADE_PGDATA="$PV/data.upgrade"
+ROLLBACK_PGDATA="$PV/data.rollback"
+
+if [ ! -e "$PGDATA/PG_VERSION" ]; then
+  echo "No data directory found at $PGDATA"
+  exit 1
+fi
+if [ "$(cat "$PGDATA/PG_VERSION")" == "$PG_MAJOR" ]; then
+  # a previous attempt has already swapped the data directories
+  echo "Data directory is already upgraded to $PG_MAJOR"
+  exit 0
+fi
+
+echo "Upgrading data directory from $(cat "$PGDATA/PG_VERSION") to $PG_MAJOR"
+
+# leftovers of a failed attempt or of the previous upgrade
+rm -rf "$UPGRADE_PGDATA" "$ROLLBACK_PGDATA"
+mkdir -p "$UPGRADE_PGDATA"
+chown postgres:postgres "$UPGRADE_PGDATA"
+chmod 0700 "$UPGRADE_PGDATA"
+
+su-exec postgres initdb --pgdata="$UPGRADE_PGDATA"
+
+# pg_upgrade writes its logs into the working directory. The old server must neither archive WAL nor wait for
+# synchronous standbys, as those are not running.
+cd /tmp
+su-exec postgres pg_upgrade \
+  --old-bindir="$OLD_INSTALLATION/bin" \
+  --new-bindir=/usr/local/bin \
+  --old-datadir="$PGDATA" \
+  --new-datadir="$UPGRADE_PGDATA" \
+  --old-options="-c archive_mode=off -c synchronous_commit=local"
+
+# keep the configuration generated for the old data directory, its settings are valid for every supported version
+cp -p "$PGDATA/postgresql.conf" "$PGDATA/pg_hba.conf" "$UPGRADE_PGDATA/"
+
+mv "$PGDATA" "$ROLLBACK_PGDATA"
+mv "$UPGRADE_PGDATA" "$PGDATA"
+
+echo "Data directory is upgraded to $PG_MAJOR"
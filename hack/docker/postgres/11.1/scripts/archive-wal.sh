@@ -0,0 +1,39 @@
+#!/usr/bin/env bash
+
+# Copyright The KubeDB Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+
+# archive_command of postgres, that pushes the WAL file in $1 with wal-g.
+# The output of a failed push is kept in /tmp/archive-last-error, for the leader election sidecar to report it,
+# until a WAL file is pushed again.
+
+ERROR_FILE=/tmp/archive-last-error
+
+# WAL is encrypted with the current key of spec.archiver.encryption
+source /scripts/encryption.sh
+if ! archive_encryption; then
+  echo "$ENCRYPTION_ERROR" | tee "$ERROR_FILE" >&2
+  exit 1
+fi
+
+if out=$(wal-g wal-push "$1" 2>&1); then
+  rm -f "$ERROR_FILE"
+  exit 0
+else
+  code=$?
+  echo "$out" | tail -n 20 >"$ERROR_FILE"
+  echo "$out" >&2
+  exit $code
+fi
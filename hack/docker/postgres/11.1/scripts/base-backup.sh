@@ -0,0 +1,94 @@
+#!/usr/bin/env bash
+
+# Copyright The KubeDB Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+
+set -eo pipefail
+
+# Pushes a base backup of the data directory to the archive with wal-g, while postgres is running on this member,
+# which may be a replica. Then, base backups beyond the number to retain in $1 are deleted along with the WAL that
+# only they need, unless it is 0. The names of the base backups in the archive are written to stdout, oldest first.
+
+RETAIN=${1:-0}
+
+# set walg ENV
+CRED_PATH="/srv/wal-g/archive/secrets"
+
+if [[ ${ARCHIVE_S3_PREFIX} != "" ]]; then
+  export WALE_S3_PREFIX="$ARCHIVE_S3_PREFIX"
+  [[ -e "$CRED_PATH/AWS_ACCESS_KEY_ID" ]] &&  export AWS_ACCESS_KEY_ID=$(cat "$CRED_PATH/AWS_ACCESS_KEY_ID")
+  [[ -e "$CRED_PATH/AWS_SECRET_ACCESS_KEY" ]] &&  export AWS_SECRET_ACCESS_KEY=$(cat "$CRED_PATH/AWS_SECRET_ACCESS_KEY")
+  if [[ ${ARCHIVE_S3_ENDPOINT} != "" ]]; then
+    [[ -e "$CRED_PATH/CA_CERT_DATA" ]] &&  export WALG_S3_CA_CERT_FILE="$CRED_PATH/CA_CERT_DATA"
+    export AWS_ENDPOINT=$ARCHIVE_S3_ENDPOINT
+    export AWS_S3_FORCE_PATH_STYLE="true"
+    export AWS_REGION="us-east-1"
+    [[ -e "$ARCHIVE_S3_REGION" ]] && export AWS_REGION=$ARCHIVE_S3_REGION
+  fi
+
+elif [[ ${ARCHIVE_GS_PREFIX} != "" ]]; then
+  export WALE_GS_PREFIX="$ARCHIVE_GS_PREFIX"
+  [[ -e "$CRED_PATH/GOOGLE_APPLICATION_CREDENTIALS" ]] && export GOOGLE_APPLICATION_CREDENTIALS="$CRED_PATH/GOOGLE_APPLICATION_CREDENTIALS"
+  [[ -e "$CRED_PATH/GOOGLE_SERVICE_ACCOUNT_JSON_KEY" ]] &&  export GOOGLE_APPLICATION_CREDENTIALS="$CRED_PATH/GOOGLE_SERVICE_ACCOUNT_JSON_KEY"
+
+elif [[ ${ARCHIVE_FILE_PREFIX} != "" ]]; then
+  export WALG_FILE_PREFIX="$ARCHIVE_FILE_PREFIX/$(hostname)"
+  mkdir -p $WALG_FILE_PREFIX
+
+elif [[ ${ARCHIVE_AZ_PREFIX} != "" ]]; then
+  export WALE_AZ_PREFIX="$ARCHIVE_AZ_PREFIX"
+  [[ -e "$CRED_PATH/AZURE_STORAGE_ACCESS_KEY" ]] && export AZURE_STORAGE_ACCESS_KEY=$(cat "$CRED_PATH/AZURE_STORAGE_ACCESS_KEY")
+  [[ -e "$CRED_PATH/AZURE_ACCOUNT_KEY" ]] && export AZURE_STORAGE_ACCESS_KEY=$(cat "$CRED_PATH/AZURE_ACCOUNT_KEY")
+  [[ -e "$CRED_PATH/AZURE_STORAGE_ACCOUNT" ]] && export AZURE_STORAGE_ACCOUNT=$(cat "$CRED_PATH/AZURE_STORAGE_ACCOUNT")
+  [[ -e "$CRED_PATH/AZURE_ACCOUNT_NAME" ]] && export AZURE_STORAGE_ACCOUNT=$(cat "$CRED_PATH/AZURE_ACCOUNT_NAME")
+
+elif [[ ${ARCHIVE_SWIFT_PREFIX} != "" ]]; then
+  export WALE_SWIFT_PREFIX="$ARCHIVE_SWIFT_PREFIX"
+  [[ -e "$CRED_PATH/OS_USERNAME" ]] &&  export OS_USERNAME=$(cat "$CRED_PATH/OS_USERNAME")
+  [[ -e "$CRED_PATH/OS_PASSWORD" ]] &&  export OS_PASSWORD=$(cat "$CRED_PATH/OS_PASSWORD")
+  [[ -e "$CRED_PATH/OS_REGION_NAME" ]] &&  export OS_REGION_NAME=$(cat "$CRED_PATH/OS_REGION_NAME")
+  [[ -e "$CRED_PATH/OS_AUTH_URL" ]] &&  export OS_AUTH_URL=$(cat "$CRED_PATH/OS_AUTH_URL")
+  #v2
+  [[ -e "$CRED_PATH/OS_TENANT_NAME" ]] &&  export OS_TENANT_NAME=$(cat "$CRED_PATH/OS_TENANT_NAME")
+  [[ -e "$CRED_PATH/OS_TENANT_ID" ]] &&  export OS_TENANT_ID=$(cat "$CRED_PATH/OS_TENANT_ID")
+  #v3
+  [[ -e "$CRED_PATH/OS_USER_DOMAIN_NAME" ]] && export OS_USER_DOMAIN_NAME=$(cat "$CRED_PATH/OS_USER_DOMAIN_NAME")
+  [[ -e "$CRED_PATH/OS_PROJECT_NAME" ]] && export OS_PROJECT_NAME=$(cat "$CRED_PATH/OS_PROJECT_NAME")
+  [[ -e "$CRED_PATH/OS_PROJECT_DOMAIN_NAME" ]] && export OS_PROJECT_DOMAIN_NAME=$(cat "$CRED_PATH/OS_PROJECT_DOMAIN_NAME")
+  #manual
+  [[ -e "$CRED_PATH/OS_STORAGE_URL" ]] && export OS_STORAGE_URL=$(cat "$CRED_PATH/OS_STORAGE_URL")
+  [[ -e "$CRED_PATH/OS_AUTH_TOKEN" ]] && export OS_AUTH_TOKEN=$(cat "$CRED_PATH/OS_AUTH_TOKEN")
+  #v1
+  [[ -e "$CRED_PATH/ST_AUTH" ]] && export ST_AUTH=$(cat "$CRED_PATH/ST_AUTH")
+  [[ -e "$CRED_PATH/ST_USER" ]] && export ST_USER=$(cat "$CRED_PATH/ST_USER")
+  [[ -e "$CRED_PATH/ST_KEY" ]] && export ST_KEY=$(cat "$CRED_PATH/ST_KEY")
+fi
+
+# base backups are encrypted with the current key of spec.archiver.encryption
+source /scripts/encryption.sh
+if ! archive_encryption; then
+  echo "$ENCRYPTION_ERROR" >&2
+  exit 1
+fi
+
+export PGUSER="postgres"
+
+wal-g backup-push "$PGDATA" >&2
+
+if [ "$RETAIN" -gt 0 ]; then
+  wal-g delete retain FULL "$RETAIN" --confirm >&2
+fi
+
+wal-g backup-list
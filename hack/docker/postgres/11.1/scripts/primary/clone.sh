@@ -0,0 +1,113 @@
+#!/bin/bash
+
+# Copyright The KubeDB Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+
+set -e
+
+# credentials of the source, prepared by the operator
+CRED_PATH="/srv/postgres/clone/secrets"
+
+until [[ -e "$CRED_PATH/CLONE_METHOD" ]]; do
+  echo "waiting for the credentials of the source..."
+  sleep 5
+done
+CLONE_METHOD=$(cat "$CRED_PATH/CLONE_METHOD")
+
+if [ "$CLONE_METHOD" == "Archive" ]; then
+  # the prefixes of the archive of the source and the recovery target are stored along with its storage credentials
+  for f in "$CRED_PATH"/RESTORE_* "$CRED_PATH"/PITR "$CRED_PATH"/TARGET_*; do
+    [[ -e "$f" ]] || continue
+    export "$(basename "$f")"="$(cat "$f")"
+  done
+  echo "Restoring Postgres from the archive of the source using wal-g"
+  /scripts/primary/restore.sh
+else
+  mkdir -p "$PGDATA"
+  rm -rf "$PGDATA"/*
+  chmod 0700 "$PGDATA"
+
+  # connect to the source as its superuser, with its replication client certificate if it requires TLS
+  (
+    export PGUSER=$(cat "$CRED_PATH/POSTGRES_USER")
+    export PGPASSWORD=$(cat "$CRED_PATH/POSTGRES_PASSWORD")
+    unset PGSSLMODE PGSSLCERT PGSSLKEY PGSSLROOTCERT
+    if [[ -e "$CRED_PATH/tls.crt" ]]; then
+      # postgres refuses keys that are readable by others
+      mkdir -p /tmp/clone
+      cp "$CRED_PATH/tls.crt" "$CRED_PATH/tls.key" "$CRED_PATH/ca.crt" /tmp/clone
+      chmod 0600 /tmp/clone/tls.key
+      export PGSSLMODE=verify-full
+      export PGSSLCERT=/tmp/clone/tls.crt
+      export PGSSLKEY=/tmp/clone/tls.key
+      export PGSSLROOTCERT=/tmp/clone/ca.crt
+    fi
+
+    # copy from a replica of the source, so that its primary is not loaded with the base backup
+    while true; do
+      pg_isready --host="$CLONE_REPLICAS_HOST" --timeout=2 &>/dev/null && host="$CLONE_REPLICAS_HOST" && break
+      pg_isready --host="$CLONE_HOST" --timeout=2 &>/dev/null && host="$CLONE_HOST" && break
+      echo "waiting for the source..."
+      sleep 2
+    done
+
+    echo "Copying data directory from $host..."
+    pg_basebackup -X stream --checkpoint=fast --no-password --pgdata "$PGDATA" --host="$host"
+  )
+
+  # a copy of a replica is started as primary
+  rm -f "$PGDATA/recovery.conf" "$PGDATA/recovery.done"
+
+  # setup postgresql.conf, the one of the source refers to its archive
+  touch /tmp/postgresql.conf
+  echo "wal_level = replica" >>/tmp/postgresql.conf
+  echo "max_wal_senders = 90" >>/tmp/postgresql.conf # default is 10.  value must be less than max_connections minus superuser_reserved_connections. ref: https://www.postgresql.org/docs/11/runtime-config-replication.html#GUC-MAX-WAL-SENDERS
+  echo "wal_keep_segments = 32" >>/tmp/postgresql.conf
+  echo "wal_log_hints = on" >>/tmp/postgresql.conf # required by pg_rewind to rejoin a former primary
+  if [ "$STREAMING" == "synchronous" ]; then
+    # setup synchronous streaming replication
+    echo "synchronous_commit = remote_write" >>/tmp/postgresql.conf
+    echo "synchronous_standby_names = '*'" >>/tmp/postgresql.conf
+  fi
+
+  if [ "$ARCHIVE" == "wal-g" ]; then
+    # setup postgresql.conf
+    echo "archive_command = '/scripts/archive-wal.sh %p'" >>/tmp/postgresql.conf
+    echo "archive_timeout = 60" >>/tmp/postgresql.conf
+    echo "archive_mode = always" >>/tmp/postgresql.conf
+  fi
+  cat /scripts/primary/postgresql.conf >> /tmp/postgresql.conf
+  mv /tmp/postgresql.conf "$PGDATA/postgresql.conf"
+fi
+
+# the copied data keeps the passwords of the source, set the superuser password of this Postgres instead
+pg_ctl -D "$PGDATA" -w start
+
+export POSTGRES_USER=${POSTGRES_USER:-postgres}
+export POSTGRES_PASSWORD=${POSTGRES_PASSWORD:-postgres}
+
+psql=(psql -v ON_ERROR_STOP=1 --username postgres --dbname postgres)
+
+if [ "$("${psql[@]}" -tAc "SELECT 1 FROM pg_roles WHERE rolname = '$POSTGRES_USER'")" == "1" ]; then
+  op="ALTER"
+else
+  op="CREATE"
+fi
+"${psql[@]}" <<-EOSQL
+    $op USER "$POSTGRES_USER" WITH SUPERUSER PASSWORD '$POSTGRES_PASSWORD';
+    ALTER USER postgres WITH PASSWORD '$POSTGRES_PASSWORD';
+EOSQL
+
+pg_ctl -D "$PGDATA" -m fast -w stop
@@ -25,7 +25,10 @@ export PGPASSWORD=${POSTGRES_PASSWORD:-postgres}
 export ARCHIVE=${ARCHIVE:-}
 
 if [ ! -e "$PGDATA/PG_VERSION" ]; then
-  if [ "$RESTORE" = true ]; then
+  if [ "$CLONE" = true ]; then
+    echo "Cloning Postgres from $CLONE_HOST"
+    /scripts/primary/clone.sh
+  elif [ "$RESTORE" = true ]; then
     echo "Restoring Postgres from base_backup using wal-g"
     /scripts/primary/restore.sh
   else
@@ -96,9 +99,18 @@ if [ "$ARCHIVE" == "wal-g" ]; then
     [[ -e "$CRED_PATH/ST_KEY" ]] && export ST_KEY=$(cat "$CRED_PATH/ST_KEY")
   fi
 
+  # the base backup is encrypted with the current key of spec.archiver.encryption
+  source /scripts/encryption.sh
+  archive_encryption || fail "$ENCRYPTION_ERROR"
+
   pg_ctl -D "$PGDATA" -w start
   PGUSER="postgres" wal-g backup-push "$PGDATA"
   pg_ctl -D "$PGDATA" -m fast -w stop
 fi
 
-exec postgres
+# data directories initialized by older images do not include spec.parameters yet
+if ! grep -q "/etc/postgresql/parameters/parameters.conf" "$PGDATA/postgresql.conf"; then
+  echo "include_if_exists = '/etc/postgresql/parameters/parameters.conf'" >>"$PGDATA/postgresql.conf"
+fi
+
+exec postgres ${POSTGRES_OPTIONS:-}
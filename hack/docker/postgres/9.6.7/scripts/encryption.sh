@@ -0,0 +1,68 @@
+#!/bin/bash
+
+# Copyright The KubeDB Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# Sourced by the scripts that run wal-g, to configure the client-side encryption of the archive.
+# The keys of an encryption secret are PGP private keys without passphrase, named by their key id.
+# A function that fails sets ENCRYPTION_ERROR to the reason.
+
+# keys of spec.archiver.encryption
+ARCHIVE_ENCRYPTION_PATH="/srv/wal-g/archive/encryption"
+# keys of the archive that is restored
+RESTORE_ENCRYPTION_PATH="/srv/wal-g/restore/encryption"
+
+# archive_encryption makes wal-g encrypt with the current key of the archive, $ARCHIVE_ENCRYPTION_KEY_ID.
+archive_encryption() {
+  unset WALG_PGP_KEY_PATH
+  if [[ -z "${ARCHIVE_ENCRYPTION_KEY_ID:-}" ]]; then
+    return 0
+  fi
+  if [[ ! -s "$ARCHIVE_ENCRYPTION_PATH/$ARCHIVE_ENCRYPTION_KEY_ID" ]]; then
+    ENCRYPTION_ERROR="encryption key \"$ARCHIVE_ENCRYPTION_KEY_ID\" is missing in the encryption secret of the archive"
+    return 1
+  fi
+  export WALG_PGP_KEY_PATH="$ARCHIVE_ENCRYPTION_PATH/$ARCHIVE_ENCRYPTION_KEY_ID"
+}
+
+# restore_encryption makes wal-g decrypt with every key of the restored archive, so that the backups encrypted
+# before a key rotation stay readable. wal-g reads a single keyring, so the keys are merged into one.
+restore_encryption() {
+  unset WALG_PGP_KEY_PATH
+  if [[ "${RESTORE_ENCRYPTION:-}" != "true" ]]; then
+    return 0
+  fi
+  export GNUPGHOME=/tmp/gnupg
+  mkdir -p -m 0700 "$GNUPGHOME"
+  for key in "$RESTORE_ENCRYPTION_PATH"/*; do
+    [[ -e "$key" ]] || continue
+    if ! gpg --batch --quiet --import "$key" &>/dev/null; then
+      ENCRYPTION_ERROR="encryption key \"$(basename "$key")\" is not a PGP private key"
+      return 1
+    fi
+  done
+  gpg --batch --armor --export-secret-keys >/tmp/wal-g-keyring.asc
+  if [[ ! -s /tmp/wal-g-keyring.asc ]]; then
+    ENCRYPTION_ERROR="encryption secret of the restored archive has no keys"
+    return 1
+  fi
+  export WALG_PGP_KEY_PATH=/tmp/wal-g-keyring.asc
+}
+
+# fail exits with the reason in $1, that is reported as the termination message of the container.
+fail() {
+  echo "$1" >&2
+  { echo "$1" >/dev/termination-log; } 2>/dev/null
+  exit 1
+}
@@ -21,6 +21,8 @@ echo "Running as Replica"
 
 # set password ENV
 export PGPASSWORD=${POSTGRES_PASSWORD:-postgres}
+# the superuser alternates between two login roles, when its password is rotated
+export PGUSER=${POSTGRES_USER:-postgres}
 
 export ARCHIVE=${ARCHIVE:-}
 
@@ -38,7 +40,7 @@ done
 
 while true; do
   echo "Attempting query on primary"
-  psql -h "$PRIMARY_HOST" --no-password --username=postgres --command="select now();" &>/dev/null && break
+  psql -h "$PRIMARY_HOST" --no-password --username="$PGUSER" --command="select now();" &>/dev/null && break
   # check if current pod became leader itself
   if [[ -e "/tmp/pg-failover-trigger" ]]; then
     echo "Postgres promotion trigger_file found. Running primary run script"
@@ -47,12 +49,16 @@ while true; do
   sleep 2
 done
 
-# get basebackup
-mkdir -p "$PGDATA"
-rm -rf "$PGDATA"/*
-chmod 0700 "$PGDATA"
+# get basebackup, unless the leader election sidecar has already rejoined the data directory of a former primary
+if [[ "${REJOIN_METHOD:-}" == "" ]]; then
+  mkdir -p "$PGDATA"
+  rm -rf "$PGDATA"/*
+  chmod 0700 "$PGDATA"
 
-pg_basebackup -X fetch --no-password --pgdata "$PGDATA" --username=postgres --host="$PRIMARY_HOST"
+  pg_basebackup -X fetch --no-password --pgdata "$PGDATA" --username="$PGUSER" --host="$PRIMARY_HOST"
+else
+  echo "Data directory is rejoined with $REJOIN_METHOD"
+fi
 
 # setup recovery.conf
 cp /scripts/replica/recovery.conf /tmp
@@ -67,6 +73,7 @@ touch /tmp/postgresql.conf
 echo "wal_level = replica" >>/tmp/postgresql.conf
 echo "max_wal_senders = 99" >>/tmp/postgresql.conf
 echo "wal_keep_segments = 32" >>/tmp/postgresql.conf
+echo "wal_log_hints = on" >>/tmp/postgresql.conf # required by pg_rewind to rejoin a former primary
 if [ "$STANDBY" == "hot" ]; then
   echo "hot_standby = on" >>/tmp/postgresql.conf
 fi
@@ -131,11 +138,11 @@ if [ "$ARCHIVE" == "wal-g" ]; then
   fi
 
   # setup postgresql.conf
-  echo "archive_command = 'wal-g wal-push %p'" >>/tmp/postgresql.conf
+  echo "archive_command = '/scripts/archive-wal.sh %p'" >>/tmp/postgresql.conf
   echo "archive_timeout = 60" >>/tmp/postgresql.conf
   echo "archive_mode = always" >>/tmp/postgresql.conf
 fi
 cat /scripts/primary/postgresql.conf >> /tmp/postgresql.conf
 mv /tmp/postgresql.conf "$PGDATA/postgresql.conf"
 
-exec postgres
+exec postgres ${POSTGRES_OPTIONS:-}
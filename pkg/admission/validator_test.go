@@ -39,6 +39,7 @@ import (
 	clientSetScheme "k8s.io/client-go/kubernetes/scheme"
 	"kmodules.xyz/client-go/meta"
 	mona "kmodules.xyz/monitoring-agent-api/api/v1"
+	store "kmodules.xyz/objectstore-api/api/v1"
 )
 
 func init() {
@@ -62,6 +63,16 @@ func TestPostgresValidator_Admit(t *testing.T) {
 					ObjectMeta: metaV1.ObjectMeta{
 						Name: "9.6",
 					},
+					Spec: catalog.PostgresVersionSpec{
+						Version: "9.6",
+						Parameters: []catalog.PostgresVersionParameter{
+							{Name: "max_connections", Context: catalog.PostgresParameterContextPostmaster, Type: catalog.PostgresParameterTypeInteger},
+							{Name: "work_mem", Context: catalog.PostgresParameterContextUser, Type: catalog.PostgresParameterTypeInteger},
+							{Name: "log_statement", Context: catalog.PostgresParameterContextSuperuser, Type: catalog.PostgresParameterTypeEnum,
+								EnumValues: []string{"none", "ddl", "mod", "all"}},
+							{Name: "port", Context: catalog.PostgresParameterContextPostmaster, Type: catalog.PostgresParameterTypeInteger},
+						},
+					},
 				},
 			)
 			validator.client = fake.NewSimpleClientset(
@@ -76,6 +87,12 @@ func TestPostgresValidator_Admit(t *testing.T) {
 						Name: "standard",
 					},
 				},
+				&storageV1beta1.StorageClass{
+					ObjectMeta: metaV1.ObjectMeta{
+						Name: "expandable",
+					},
+					AllowVolumeExpansion: types.BoolP(true),
+				},
 			)
 
 			objJS, err := meta.MarshalToJson(&c.object, api.SchemeGroupVersion)
@@ -215,6 +232,344 @@ var cases = []struct {
 		false,
 		true,
 	},
+	{"Increase Spec.Storage",
+		requestKind,
+		"foo",
+		"default",
+		admission.Update,
+		editStorageSize(expandablePostgres(), "200Mi"),
+		expandablePostgres(),
+		false,
+		true,
+	},
+	{"Decrease Spec.Storage",
+		requestKind,
+		"foo",
+		"default",
+		admission.Update,
+		editStorageSize(expandablePostgres(), "50Mi"),
+		expandablePostgres(),
+		false,
+		false,
+	},
+	{"Increase Spec.Storage with StorageClass without Volume Expansion",
+		requestKind,
+		"foo",
+		"default",
+		admission.Update,
+		editStorageSize(samplePostgres(), "200Mi"),
+		samplePostgres(),
+		false,
+		false,
+	},
+	{"Edit Spec.Storage.StorageClassName",
+		requestKind,
+		"foo",
+		"default",
+		admission.Update,
+		expandablePostgres(),
+		samplePostgres(),
+		false,
+		false,
+	},
+	{"Create Postgres with invalid Spec.PasswordRotation.Schedule",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editPasswordRotationSchedule(samplePostgres(), "every day"),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Create Postgres with Spec.PasswordRotation.Schedule",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editPasswordRotationSchedule(samplePostgres(), "0 3 * * 0"),
+		api.Postgres{},
+		false,
+		true,
+	},
+	{"Create Postgres with invalid Spec.Archiver.BaseBackup.Schedule",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editBaseBackup(samplePostgres(), "every night", 3),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Create Postgres with negative Spec.Archiver.BaseBackup.Retain",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editBaseBackup(samplePostgres(), "0 2 * * *", -1),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Edit Postgres Spec.Archiver.BaseBackup.Schedule",
+		requestKind,
+		"foo",
+		"default",
+		admission.Update,
+		editBaseBackup(samplePostgres(), "0 2 * * *", 3),
+		editBaseBackup(samplePostgres(), "0 3 * * 0", 7),
+		false,
+		true,
+	},
+	{"Create Postgres with empty Spec.Archiver.Retention",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editArchiveRetention(samplePostgres(), api.PostgresArchiveRetentionSpec{DryRun: true}),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Edit Postgres Spec.Archiver.Retention",
+		requestKind,
+		"foo",
+		"default",
+		admission.Update,
+		editArchiveRetention(samplePostgres(), api.PostgresArchiveRetentionSpec{FullBackups: 3, Days: 7}),
+		editArchiveRetention(samplePostgres(), api.PostgresArchiveRetentionSpec{Days: 14, DryRun: true}),
+		false,
+		true,
+	},
+	{"Create Postgres with Spec.Archiver.BaseBackup.Retain and Spec.Archiver.Retention",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editBaseBackupRetain(editArchiveRetention(samplePostgres(), api.PostgresArchiveRetentionSpec{FullBackups: 3}), 3),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Create Postgres with negative Spec.Archiver.MaximumPendingWALFiles",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editMaximumPendingWALFiles(samplePostgres(), -1),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Create Postgres with Spec.Archiver.Encryption without KeyID",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editArchiveEncryption(samplePostgres(), "foo-encryption", ""),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Edit Postgres Spec.Archiver.Encryption.KeyID",
+		requestKind,
+		"foo",
+		"default",
+		admission.Update,
+		editArchiveEncryption(samplePostgres(), "foo-encryption", "key-2"),
+		editArchiveEncryption(samplePostgres(), "foo-encryption", "key-1"),
+		false,
+		true,
+	},
+	{"Edit Postgres Spec.Archiver.Encryption.SecretName",
+		requestKind,
+		"foo",
+		"default",
+		admission.Update,
+		editArchiveEncryption(samplePostgres(), "bar-encryption", "key-1"),
+		editArchiveEncryption(samplePostgres(), "foo-encryption", "key-1"),
+		false,
+		false,
+	},
+	{"Create Postgres with Spec.Parameters",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editParameters(samplePostgres(), map[string]string{"max_connections": "200", "work_mem": "4MB", "log_statement": "ddl"}),
+		api.Postgres{},
+		false,
+		true,
+	},
+	{"Create Postgres with unknown Spec.Parameters",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editParameters(samplePostgres(), map[string]string{"no_such_parameter": "on"}),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Create Postgres with protected Spec.Parameters",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editParameters(samplePostgres(), map[string]string{"port": "5433"}),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Create Postgres with invalid Spec.Parameters value",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editParameters(samplePostgres(), map[string]string{"log_statement": "everything"}),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Create Postgres with Spec.ClientAuthentication",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editClientAuthentication(samplePostgres(), false, api.PostgresHBARule{
+			Type: api.PostgresHBATypeHost, Database: "app", User: "app", Address: "10.0.0.0/8", Method: "md5",
+		}),
+		api.Postgres{},
+		false,
+		true,
+	},
+	{"Create Postgres with invalid Spec.ClientAuthentication address",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editClientAuthentication(samplePostgres(), false, api.PostgresHBARule{
+			Type: api.PostgresHBATypeHost, Database: "app", User: "app", Address: "10.0.0.0/33", Method: "md5",
+		}),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Create Postgres with Spec.ClientAuthentication method peer for remote connections",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editClientAuthentication(samplePostgres(), false, api.PostgresHBARule{
+			Type: api.PostgresHBATypeHost, Database: "all", User: "all", Address: "all", Method: "peer",
+		}),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Create Postgres with Spec.ClientAuthentication accepting connections without TLS",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editClientAuthentication(samplePostgres(), true, api.PostgresHBARule{
+			Type: api.PostgresHBATypeHostNoSSL, Database: "all", User: "all", Address: "0.0.0.0/0", Method: "trust",
+		}),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Create Postgres cloned from itself",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editClone(samplePostgres(), "default", "foo", ""),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Create Postgres cloned with invalid Spec.Init.PostgresClone.Method",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editClone(samplePostgres(), "prod", "foo", "Dump"),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Create Postgres cloned from non existing Postgres",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editClone(samplePostgres(), "prod", "foo", api.PostgresCloneMethodBaseBackup),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Create Postgres cloned to a point in time with method BaseBackup",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editClonePITR(samplePostgres(), api.PostgresCloneMethodBaseBackup, api.RecoveryTarget{TargetName: "before-migration"}),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Create Postgres cloned to more than one recovery target",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editClonePITR(samplePostgres(), "", api.RecoveryTarget{TargetTime: "2020-01-02 15:04:05+00", TargetLSN: "0/3000060"}),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Create Postgres cloned to Spec.Init.PostgresClone.PITR.TargetXID",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editClonePITR(samplePostgres(), "", api.RecoveryTarget{TargetXID: "1234"}),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Create Postgres cloned to Spec.Init.PostgresClone.PITR.TargetName",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editClonePITR(samplePostgres(), "", api.RecoveryTarget{TargetName: "before-migration"}),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Create Postgres cloned to invalid Spec.Init.PostgresClone.PITR.TargetTime",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editClonePITR(samplePostgres(), "", api.RecoveryTarget{TargetTime: "yesterday"}),
+		api.Postgres{},
+		false,
+		false,
+	},
+	{"Create Postgres cloned to Spec.Init.PostgresClone.PITR.TargetLSN before postgres 10",
+		requestKind,
+		"foo",
+		"default",
+		admission.Create,
+		editClonePITR(samplePostgres(), "", api.RecoveryTarget{TargetLSN: "0/3000060"}),
+		api.Postgres{},
+		false,
+		false,
+	},
 	{"Delete Postgres when Spec.TerminationPolicy=DoNotTerminate",
 		requestKind,
 		"foo",
@@ -335,6 +690,98 @@ func editSpecInvalidMonitor(old api.Postgres) api.Postgres {
 	return old
 }
 
+func expandablePostgres() api.Postgres {
+	postgres := samplePostgres()
+	postgres.Spec.Storage.StorageClassName = types.StringP("expandable")
+	return postgres
+}
+
+func editStorageSize(old api.Postgres, size string) api.Postgres {
+	old.Spec.Storage.Resources.Requests = core.ResourceList{
+		core.ResourceStorage: resource.MustParse(size),
+	}
+	return old
+}
+
+func editPasswordRotationSchedule(old api.Postgres, schedule string) api.Postgres {
+	old.Spec.PasswordRotation = &api.PostgresPasswordRotationSpec{
+		Schedule: schedule,
+	}
+	return old
+}
+
+func editBaseBackup(old api.Postgres, schedule string, retain int32) api.Postgres {
+	old.Spec.Archiver = &api.PostgresArchiverSpec{
+		Storage: &store.Backend{
+			StorageSecretName: "foo-archiver",
+			S3:                &store.S3Spec{Bucket: "kubedb"},
+		},
+		BaseBackup: &api.PostgresBaseBackupSpec{
+			Schedule: schedule,
+			Retain:   retain,
+		},
+	}
+	return old
+}
+
+func editArchiveRetention(old api.Postgres, retention api.PostgresArchiveRetentionSpec) api.Postgres {
+	old = editBaseBackup(old, "0 2 * * *", 0)
+	old.Spec.Archiver.Retention = &retention
+	return old
+}
+
+func editBaseBackupRetain(old api.Postgres, retain int32) api.Postgres {
+	old.Spec.Archiver.BaseBackup.Retain = retain
+	return old
+}
+
+func editMaximumPendingWALFiles(old api.Postgres, files int32) api.Postgres {
+	old = editBaseBackup(old, "0 2 * * *", 0)
+	old.Spec.Archiver.MaximumPendingWALFiles = files
+	return old
+}
+
+func editArchiveEncryption(old api.Postgres, secretName, keyID string) api.Postgres {
+	old = editBaseBackup(old, "0 2 * * *", 0)
+	old.Spec.Archiver.Encryption = &api.BackupEncryptionSpec{
+		SecretName: secretName,
+		KeyID:      keyID,
+	}
+	return old
+}
+
+func editParameters(old api.Postgres, params map[string]string) api.Postgres {
+	old.Spec.Parameters = params
+	return old
+}
+
+func editClientAuthentication(old api.Postgres, requireSSL bool, rules ...api.PostgresHBARule) api.Postgres {
+	if requireSSL {
+		old.Spec.TLS = &api.PostgresTLSConfig{RequireSSL: true}
+	}
+	old.Spec.ClientAuthentication = &api.PostgresClientAuthenticationSpec{
+		Rules: rules,
+	}
+	return old
+}
+
+func editClone(old api.Postgres, namespace, name string, method api.PostgresCloneMethod) api.Postgres {
+	old.Spec.Init = &api.InitSpec{
+		PostgresClone: &api.PostgresCloneSourceSpec{
+			Namespace: namespace,
+			Name:      name,
+			Method:    method,
+		},
+	}
+	return old
+}
+
+func editClonePITR(old api.Postgres, method api.PostgresCloneMethod, pitr api.RecoveryTarget) api.Postgres {
+	old = editClone(old, "prod", "bar", method)
+	old.Spec.Init.PostgresClone.PITR = &pitr
+	return old
+}
+
 func pauseDatabase(old api.Postgres) api.Postgres {
 	old.Spec.TerminationPolicy = api.TerminationPolicyPause
 	return old
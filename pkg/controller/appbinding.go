@@ -43,6 +43,14 @@ func (c *Controller) ensureAppBinding(db *api.Postgres, postgresVersion *catalog
 		return kutil.VerbUnchanged, err
 	}
 
+	query, caBundle := "sslmode=disable", []byte(nil)
+	if db.Spec.TLS != nil {
+		if caBundle, err = c.caBundle(db); err != nil {
+			return kutil.VerbUnchanged, err
+		}
+		query = "sslmode=verify-full"
+	}
+
 	_, vt, err := appcat_util.CreateOrPatchAppBinding(c.AppCatalogClient.AppcatalogV1alpha1(), meta, func(in *appcat.AppBinding) *appcat.AppBinding {
 		core_util.EnsureOwnerReference(&in.ObjectMeta, ref)
 		in.Labels = db.OffshootLabels()
@@ -54,8 +62,9 @@ func (c *Controller) ensureAppBinding(db *api.Postgres, postgresVersion *catalog
 			Name:   db.ServiceName(),
 			Port:   defaultDBPort.Port,
 			Path:   "/",
-			Query:  "sslmode=disable", // TODO: Fix when sslmode is supported
+			Query:  query,
 		}
+		in.Spec.ClientConfig.CABundle = caBundle
 		in.Spec.ClientConfig.InsecureSkipTLSVerify = false
 
 		in.Spec.Secret = &core.LocalObjectReference{
@@ -0,0 +1,267 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	"kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1/util"
+
+	"github.com/appscode/go/log"
+	"gomodules.xyz/stow"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// period at which the recoverability windows of the archives are computed
+	recoveryWindowSyncPeriod = 10 * time.Minute
+
+	// layout of the archive, as written by wal-g
+	walgBackupsDir     = "basebackups_005"
+	walgWALDir         = "wal_005"
+	walgSentinelSuffix = "_backup_stop_sentinel.json"
+)
+
+// archiveItem is an object in the archive
+type archiveItem struct {
+	id      string
+	lastMod time.Time
+}
+
+// archiveBackup is a base backup in the archive
+type archiveBackup struct {
+	name string
+	// finished is the time that the stop sentinel was written, zero if the base backup is incomplete
+	finished time.Time
+	items    []string
+}
+
+// walSegment is a WAL segment or backup history file in the archive
+type walSegment struct {
+	id      string
+	lastMod time.Time
+	// position is the log and segment number of the file name, without the timeline
+	position string
+}
+
+// listArchive returns the objects in the archive of postgres.
+func (c *Controller) listArchive(postgres *api.Postgres) ([]archiveItem, error) {
+	container, err := c.archiveContainer(postgres)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []archiveItem
+	cursor := stow.CursorStart
+	for {
+		page, next, err := container.Items(WalDataDir(postgres), cursor, 50)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page {
+			lastMod, err := item.LastMod()
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, archiveItem{id: item.ID(), lastMod: lastMod})
+		}
+		cursor = next
+		if stow.IsCursorEnd(cursor) {
+			break
+		}
+	}
+	return items, nil
+}
+
+// parseArchive returns the base backups by name and the WAL segments, that items under prefix belong to.
+// Timeline history files and unknown objects are left out.
+func parseArchive(prefix string, items []archiveItem) (map[string]*archiveBackup, []walSegment) {
+	backups := map[string]*archiveBackup{}
+	backup := func(name string) *archiveBackup {
+		if b, found := backups[name]; found {
+			return b
+		}
+		b := &archiveBackup{name: name}
+		backups[name] = b
+		return b
+	}
+	var segments []walSegment
+
+	for _, item := range items {
+		rel := strings.TrimPrefix(strings.TrimPrefix(item.id, prefix), "/")
+		switch {
+		case strings.HasPrefix(rel, walgBackupsDir+"/"):
+			rel = strings.TrimPrefix(rel, walgBackupsDir+"/")
+			if i := strings.Index(rel, "/"); i >= 0 {
+				b := backup(rel[:i])
+				b.items = append(b.items, item.id)
+			} else if strings.HasSuffix(rel, walgSentinelSuffix) {
+				b := backup(strings.TrimSuffix(rel, walgSentinelSuffix))
+				b.finished = item.lastMod
+				b.items = append(b.items, item.id)
+			}
+		case strings.HasPrefix(rel, walgWALDir+"/"):
+			if name := path.Base(rel); isWALFileName(name) {
+				segments = append(segments, walSegment{id: item.id, lastMod: item.lastMod, position: name[8:24]})
+			}
+		}
+	}
+	return backups, segments
+}
+
+// fullBackups returns the complete full base backups, oldest first. Delta base backups are left out, as they
+// depend on the full base backup that they are taken from.
+func fullBackups(backups map[string]*archiveBackup) []*archiveBackup {
+	var full []*archiveBackup
+	for _, b := range backups {
+		if !b.finished.IsZero() && !strings.Contains(b.name, "_D_") {
+			full = append(full, b)
+		}
+	}
+	sort.Slice(full, func(i, j int) bool { return full[i].finished.Before(full[j].finished) })
+	return full
+}
+
+// isWALFileName returns true, if name is a WAL segment or a backup history file, that starts with the timeline,
+// log and segment number of the WAL segment in hex
+func isWALFileName(name string) bool {
+	if len(name) < 24 {
+		return false
+	}
+	for _, r := range name[:24] {
+		if !strings.ContainsRune("0123456789ABCDEF", r) {
+			return false
+		}
+	}
+	return len(name) == 24 || name[24] == '.'
+}
+
+// backupStartPosition returns the log and segment number of the WAL segment that the base backup starts in,
+// from its name "base_<WAL segment>". It is empty, if the name has no such form.
+func backupStartPosition(name string) string {
+	if !strings.HasPrefix(name, "base_") {
+		return ""
+	}
+	segment := strings.TrimPrefix(name, "base_")
+	if len(segment) < 24 || !isWALFileName(segment[:24]) {
+		return ""
+	}
+	return segment[8:24]
+}
+
+// recoveryWindow returns the earliest and the latest point in time, that can be recovered from the archive.
+// The window starts at the end of the oldest complete full base backup and ends at the time that the latest WAL
+// segment after it was stored. Both are zero, if the archive has no complete full base backup.
+func recoveryWindow(prefix string, items []archiveItem) (earliest, latest time.Time) {
+	backups, segments := parseArchive(prefix, items)
+	full := fullBackups(backups)
+	if len(full) == 0 {
+		return
+	}
+	earliest, latest = full[0].finished, full[0].finished
+	for _, s := range segments {
+		if s.lastMod.After(latest) {
+			latest = s.lastMod
+		}
+	}
+	return
+}
+
+// walSegmentSize is the size of the WAL segments, that the log and segment numbers of WAL file names count in
+const walSegmentSize = 16 << 20
+
+// positionLSN returns the WAL position, that the WAL segment with the log and segment number position starts at.
+func positionLSN(position string) (uint64, error) {
+	logNumber, err := strconv.ParseUint(position[:8], 16, 32)
+	if err != nil {
+		return 0, err
+	}
+	seg, err := strconv.ParseUint(position[8:], 16, 32)
+	if err != nil {
+		return 0, err
+	}
+	return logNumber<<32 + seg*walSegmentSize, nil
+}
+
+// recoveryLSNWindow returns the earliest and the latest WAL position, that can be recovered from the archive.
+// The window starts at the WAL segment that the oldest complete full base backup starts in, and ends with the
+// latest WAL segment after it. Both are zero, if the archive has no complete full base backup.
+func recoveryLSNWindow(prefix string, items []archiveItem) (start, end uint64) {
+	backups, segments := parseArchive(prefix, items)
+	full := fullBackups(backups)
+	if len(full) == 0 {
+		return
+	}
+	position := backupStartPosition(full[0].name)
+	if position == "" {
+		return
+	}
+	start, err := positionLSN(position)
+	if err != nil {
+		return 0, 0
+	}
+	end = start + walSegmentSize
+	for _, s := range segments {
+		if s.position < position {
+			continue
+		}
+		if lsn, err := positionLSN(s.position); err == nil && lsn+walSegmentSize > end {
+			end = lsn + walSegmentSize
+		}
+	}
+	return
+}
+
+// syncRecoveryWindows records the recoverability window of the archive of every Postgres in its status.
+func (c *Controller) syncRecoveryWindows() {
+	dbs, err := c.pgLister.List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	for _, postgres := range dbs {
+		if postgres.DeletionTimestamp != nil || postgres.Spec.Archiver == nil ||
+			postgres.Spec.Archiver.Storage == nil || postgres.Spec.Archiver.Storage.Local != nil {
+			continue
+		}
+		if err := c.syncRecoveryWindow(postgres.DeepCopy()); err != nil {
+			log.Errorf("failed to compute recoverability window of Postgres %s/%s. Reason: %v", postgres.Namespace, postgres.Name, err)
+		}
+	}
+}
+
+func (c *Controller) syncRecoveryWindow(postgres *api.Postgres) error {
+	items, err := c.listArchive(postgres)
+	if err != nil {
+		return err
+	}
+	earliest, latest := recoveryWindow(WalDataDir(postgres), items)
+
+	_, err = util.UpdatePostgresStatus(c.ExtClient.KubedbV1alpha1(), postgres, func(in *api.PostgresStatus) *api.PostgresStatus {
+		if in.Archive == nil {
+			in.Archive = &api.PostgresArchiveStatus{}
+		}
+		in.Archive.EarliestRecoveryTime = optionalTime(earliest)
+		in.Archive.LatestRecoveryTime = optionalTime(latest)
+		return in
+	})
+	return err
+}
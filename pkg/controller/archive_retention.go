@@ -0,0 +1,234 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	"kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1/util"
+
+	"github.com/appscode/go/log"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	EventReasonArchivePruned      = "ArchivePruned"
+	EventReasonArchivePruneFailed = "ArchivePruneFailed"
+
+	// period at which the archives are pruned by spec.archiver.retention
+	archivePruneSyncPeriod = time.Hour
+)
+
+// archivePruning is what pruning removes from the archive, to keep spec.archiver.retention
+type archivePruning struct {
+	// oldest is the oldest retained full base backup, that every retained point in time is recovered from
+	oldest   string
+	backups  []string
+	segments int64
+	items    []string
+}
+
+// pruneArchives prunes the archive of every Postgres with spec.archiver.retention.
+func (c *Controller) pruneArchives() {
+	dbs, err := c.pgLister.List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	for _, postgres := range dbs {
+		if postgres.DeletionTimestamp != nil || postgres.Spec.Archiver == nil ||
+			postgres.Spec.Archiver.Storage == nil || postgres.Spec.Archiver.Retention == nil {
+			continue
+		}
+		if err := c.pruneArchive(postgres.DeepCopy()); err != nil {
+			log.Errorf("failed to prune archive of Postgres %s/%s. Reason: %v", postgres.Namespace, postgres.Name, err)
+		}
+	}
+}
+
+// pruneArchive removes the base backups and WAL segments from the archive of postgres, that are older than the
+// oldest full base backup retained by spec.archiver.retention. In dry-run mode, they are only reported.
+func (c *Controller) pruneArchive(postgres *api.Postgres) error {
+	if postgres.Spec.Archiver.Storage.Local != nil {
+		// the operator does not mount the local archive
+		return nil
+	}
+	retention := postgres.Spec.Archiver.Retention
+
+	pruning, err := c.planArchivePruning(postgres, time.Now())
+	if err == nil && !retention.DryRun {
+		err = c.removeArchiveItems(postgres, pruning.items)
+	}
+	if err != nil {
+		c.recorder.Eventf(
+			postgres,
+			core.EventTypeWarning,
+			EventReasonArchivePruneFailed,
+			"Failed to prune archive. Reason: %v",
+			err,
+		)
+		return c.updateArchivePruning(postgres, nil, retention.DryRun, err.Error())
+	}
+
+	if len(pruning.items) > 0 {
+		verb := "Pruned"
+		if retention.DryRun {
+			verb = "Dry run: would prune"
+		}
+		c.recorder.Eventf(
+			postgres,
+			core.EventTypeNormal,
+			EventReasonArchivePruned,
+			"%s %d base backups and %d WAL segments older than base backup %s",
+			verb,
+			len(pruning.backups),
+			pruning.segments,
+			pruning.oldest,
+		)
+	}
+	return c.updateArchivePruning(postgres, pruning, retention.DryRun, "")
+}
+
+// planArchivePruning lists the archive of postgres, and returns what is pruned by spec.archiver.retention.
+func (c *Controller) planArchivePruning(postgres *api.Postgres, now time.Time) (*archivePruning, error) {
+	items, err := c.listArchive(postgres)
+	if err != nil {
+		return nil, err
+	}
+	return pruneArchiveItems(WalDataDir(postgres), items, postgres.Spec.Archiver.Retention, now), nil
+}
+
+// pruneArchiveItems returns the items that are older than the oldest full base backup retained by retention.
+// These are the older base backups, including incomplete ones, and the WAL segments that precede the start of
+// the oldest retained base backup. Timeline history files are always kept. Nothing is pruned, while the archive
+// has no complete full base backup.
+func pruneArchiveItems(prefix string, items []archiveItem, retention *api.PostgresArchiveRetentionSpec, now time.Time) *archivePruning {
+	backups, segments := parseArchive(prefix, items)
+	full := fullBackups(backups)
+	if len(full) == 0 {
+		return &archivePruning{}
+	}
+
+	oldest := len(full) - 1
+	if n := int(retention.FullBackups); n > 0 {
+		oldest = len(full) - n
+		if oldest < 0 {
+			oldest = 0
+		}
+	}
+	if retention.Days > 0 {
+		cutoff := now.Add(-time.Duration(retention.Days) * 24 * time.Hour)
+		// the latest full base backup before the cutoff is needed to recover to the cutoff
+		i := sort.Search(len(full), func(i int) bool { return !full[i].finished.Before(cutoff) })
+		if i > 0 {
+			i--
+		}
+		if i < oldest {
+			oldest = i
+		}
+	}
+	retained := full[oldest]
+	start := backupStartPosition(retained.name)
+
+	pruning := &archivePruning{oldest: retained.name}
+	var names []string
+	for name := range backups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		b := backups[name]
+		if b.finished.IsZero() {
+			// an incomplete base backup is pruned, only if it can not be in progress anymore
+			if start == "" || backupStartPosition(name) == "" || backupStartPosition(name) >= start {
+				continue
+			}
+		} else if !b.finished.Before(retained.finished) {
+			continue
+		}
+		pruning.backups = append(pruning.backups, name)
+		pruning.items = append(pruning.items, b.items...)
+	}
+	if start != "" {
+		for _, s := range segments {
+			if s.position < start {
+				pruning.segments++
+				pruning.items = append(pruning.items, s.id)
+			}
+		}
+	}
+	return pruning
+}
+
+func (c *Controller) removeArchiveItems(postgres *api.Postgres, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	container, err := c.archiveContainer(postgres)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err := container.RemoveItem(id); err != nil {
+			return fmt.Errorf("failed to remove %s. Reason: %v", id, err)
+		}
+	}
+	return nil
+}
+
+// updateArchivePruning records the last pruning in the status of postgres. The base backups that are removed
+// are dropped from the base backups recorded by the sidecar.
+func (c *Controller) updateArchivePruning(postgres *api.Postgres, pruning *archivePruning, dryRun bool, reason string) error {
+	pg, err := util.UpdatePostgresStatus(c.ExtClient.KubedbV1alpha1(), postgres, func(in *api.PostgresStatus) *api.PostgresStatus {
+		if in.Archive == nil {
+			in.Archive = &api.PostgresArchiveStatus{}
+		}
+		now := metav1.Now()
+		in.Archive.LastPruneTime = &now
+		in.Archive.DryRun = dryRun
+		in.Archive.Reason = reason
+		if pruning == nil {
+			return in
+		}
+		in.Archive.OldestBackup = pruning.oldest
+		in.Archive.PrunedBackups = pruning.backups
+		in.Archive.PrunedWALSegments = pruning.segments
+		if !dryRun && in.BaseBackup != nil {
+			pruned := map[string]bool{}
+			for _, name := range pruning.backups {
+				pruned[name] = true
+			}
+			var backups []string
+			for _, name := range in.BaseBackup.Backups {
+				if !pruned[name] {
+					backups = append(backups, name)
+				}
+			}
+			in.BaseBackup.Backups = backups
+		}
+		return in
+	})
+	if err != nil {
+		return err
+	}
+	postgres.Status = pg.Status
+	return nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+)
+
+const testArchivePrefix = "kubedb/default/foo/archive"
+
+// backupItems returns the objects of the base backup name, as written by wal-g. Its stop sentinel is left out,
+// if finished is zero.
+func backupItems(name string, finished time.Time) []archiveItem {
+	items := []archiveItem{
+		{id: testArchivePrefix + "/" + walgBackupsDir + "/" + name + "/tar_partitions/part_1.tar.lz4", lastMod: finished},
+	}
+	if !finished.IsZero() {
+		items = append(items, archiveItem{id: testArchivePrefix + "/" + walgBackupsDir + "/" + name + walgSentinelSuffix, lastMod: finished})
+	}
+	return items
+}
+
+// walItems returns the objects of the archived WAL files names, as written by wal-g.
+func walItems(lastMod time.Time, names ...string) []archiveItem {
+	var items []archiveItem
+	for _, name := range names {
+		items = append(items, archiveItem{id: testArchivePrefix + "/" + walgWALDir + "/" + name + ".lz4", lastMod: lastMod})
+	}
+	return items
+}
+
+func TestPruneArchiveItems(t *testing.T) {
+	day := func(n int) time.Time {
+		return time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(n) * 24 * time.Hour)
+	}
+	var archive []archiveItem
+	archive = append(archive, backupItems("base_000000010000000000000002", day(0))...)
+	// abandoned, as it starts before the retained base backups
+	archive = append(archive, backupItems("base_000000010000000000000003", time.Time{})...)
+	archive = append(archive, backupItems("base_000000010000000000000005", day(1))...)
+	archive = append(archive, backupItems("base_000000010000000000000008", day(2))...)
+	archive = append(archive, backupItems("base_000000010000000000000009_D_000000010000000000000008", day(2).Add(time.Hour))...)
+	archive = append(archive, backupItems("base_00000001000000000000000A", day(3))...)
+	// in progress
+	archive = append(archive, backupItems("base_00000001000000000000000B", time.Time{})...)
+	archive = append(archive, walItems(day(0),
+		"000000010000000000000001",
+		"000000010000000000000002",
+		"000000010000000000000003",
+		"000000010000000000000004",
+		"000000010000000000000005",
+		"000000010000000000000005.00000028.backup",
+		"000000010000000000000006",
+		"000000010000000000000007",
+		"000000010000000000000008",
+		"000000010000000000000009",
+		"00000001000000000000000A",
+		"00000001000000000000000B",
+		"00000002.history",
+	)...)
+
+	cases := []struct {
+		name      string
+		archive   []archiveItem
+		retention api.PostgresArchiveRetentionSpec
+		now       time.Time
+		oldest    string
+		backups   []string
+		segments  int64
+	}{
+		{
+			name:      "newest full base backups",
+			archive:   archive,
+			retention: api.PostgresArchiveRetentionSpec{FullBackups: 2},
+			now:       day(3),
+			oldest:    "base_000000010000000000000008",
+			backups: []string{
+				"base_000000010000000000000002",
+				"base_000000010000000000000003",
+				"base_000000010000000000000005",
+			},
+			// 1 to 7 and the backup history file of 5
+			segments: 8,
+		},
+		{
+			name:      "fewer full base backups than retained",
+			archive:   archive,
+			retention: api.PostgresArchiveRetentionSpec{FullBackups: 10},
+			now:       day(3),
+			oldest:    "base_000000010000000000000002",
+			segments:  1,
+		},
+		{
+			name:      "newest full base backup by default",
+			archive:   archive,
+			retention: api.PostgresArchiveRetentionSpec{},
+			now:       day(3),
+			oldest:    "base_00000001000000000000000A",
+			backups: []string{
+				"base_000000010000000000000002",
+				"base_000000010000000000000003",
+				"base_000000010000000000000005",
+				"base_000000010000000000000008",
+				"base_000000010000000000000009_D_000000010000000000000008",
+			},
+			segments: 10,
+		},
+		{
+			name:      "days retain more than full base backups",
+			archive:   archive,
+			retention: api.PostgresArchiveRetentionSpec{FullBackups: 1, Days: 2},
+			now:       day(3).Add(time.Hour),
+			// the latest full base backup before the cutoff at day 1
+			oldest: "base_000000010000000000000005",
+			backups: []string{
+				"base_000000010000000000000002",
+				"base_000000010000000000000003",
+			},
+			segments: 4,
+		},
+		{
+			name:      "full base backups retain more than days",
+			archive:   archive,
+			retention: api.PostgresArchiveRetentionSpec{FullBackups: 2, Days: 1},
+			now:       day(3).Add(time.Hour),
+			oldest:    "base_000000010000000000000008",
+			backups: []string{
+				"base_000000010000000000000002",
+				"base_000000010000000000000003",
+				"base_000000010000000000000005",
+			},
+			segments: 8,
+		},
+		{
+			name: "no complete full base backup",
+			archive: append(backupItems("base_000000010000000000000002", time.Time{}),
+				walItems(day(0), "000000010000000000000001", "000000010000000000000002")...),
+			retention: api.PostgresArchiveRetentionSpec{FullBackups: 1},
+			now:       day(3),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			pruning := pruneArchiveItems(testArchivePrefix, c.archive, &c.retention, c.now)
+			if pruning.oldest != c.oldest {
+				t.Errorf("expected oldest base backup %q, got %q", c.oldest, pruning.oldest)
+			}
+			if !reflect.DeepEqual(pruning.backups, c.backups) {
+				t.Errorf("expected pruned base backups %v, got %v", c.backups, pruning.backups)
+			}
+			if pruning.segments != c.segments {
+				t.Errorf("expected %d pruned WAL segments, got %d", c.segments, pruning.segments)
+			}
+
+			// a base backup is pruned as a whole, and the WAL from the start of the oldest retained one is kept
+			pruned := map[string]bool{}
+			for _, id := range pruning.items {
+				pruned[id] = true
+			}
+			prunedBackups := map[string]bool{}
+			for _, name := range pruning.backups {
+				prunedBackups[name] = true
+			}
+			start := backupStartPosition(c.oldest)
+			for _, item := range c.archive {
+				rel := strings.TrimPrefix(item.id, testArchivePrefix+"/")
+				if strings.HasPrefix(rel, walgBackupsDir+"/") {
+					name := strings.SplitN(strings.TrimPrefix(rel, walgBackupsDir+"/"), "/", 2)[0]
+					name = strings.TrimSuffix(name, walgSentinelSuffix)
+					if pruned[item.id] != prunedBackups[name] {
+						t.Errorf("expected %s to be pruned together with base backup %s only", item.id, name)
+					}
+				} else if name := path.Base(rel); pruned[item.id] && !(isWALFileName(name) && name[8:24] < start) {
+					t.Errorf("expected %s to be kept, as it is needed by base backup %s", item.id, c.oldest)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"testing"
+	"time"
+
+	le "kubedb.dev/postgres/pkg/leader_election"
+)
+
+func TestPositionLSN(t *testing.T) {
+	cases := []struct {
+		position string
+		want     string
+	}{
+		{"0000000000000002", "0/2000000"},
+		{"00000000000000FF", "0/FF000000"},
+		{"0000000100000000", "1/0"},
+		{"00000016000000B3", "16/B3000000"},
+	}
+	for _, c := range cases {
+		t.Run(c.position, func(t *testing.T) {
+			want, err := le.ParseLSN(c.want)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got, err := positionLSN(c.position)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != want {
+				t.Errorf("positionLSN(%q) = %X, want %X", c.position, got, want)
+			}
+		})
+	}
+}
+
+func TestRecoveryLSNWindow(t *testing.T) {
+	finished := time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)
+	archive := func(backups []string, segments ...string) []archiveItem {
+		var items []archiveItem
+		for i, name := range backups {
+			items = append(items, backupItems(name, finished.Add(time.Duration(i)*time.Hour))...)
+		}
+		return append(items, walItems(finished, segments...)...)
+	}
+
+	cases := []struct {
+		name  string
+		items []archiveItem
+		start string
+		end   string
+	}{
+		{
+			name:  "no complete full base backup",
+			items: append(backupItems("base_000000010000000000000002", time.Time{}), walItems(finished, "000000010000000000000002")...),
+			start: "0/0",
+			end:   "0/0",
+		},
+		{
+			name:  "base backup without WAL",
+			items: archive([]string{"base_000000010000000000000002"}),
+			start: "0/2000000",
+			end:   "0/3000000",
+		},
+		{
+			name:  "WAL of a single log",
+			items: archive([]string{"base_000000010000000000000002"}, "000000010000000000000002", "000000010000000000000003"),
+			start: "0/2000000",
+			end:   "0/4000000",
+		},
+		{
+			name: "WAL across logs",
+			items: archive([]string{"base_0000000100000000000000FE"},
+				"0000000100000000000000FE",
+				"0000000100000000000000FF",
+				"000000010000000100000000",
+				"000000010000000100000001",
+			),
+			start: "0/FE000000",
+			end:   "1/2000000",
+		},
+		{
+			name:  "hex log numbers",
+			items: archive([]string{"base_0000000100000009000000FF"}, "0000000100000009000000FF", "000000010000000A00000000"),
+			start: "9/FF000000",
+			end:   "A/1000000",
+		},
+		{
+			name: "WAL before the base backup",
+			items: archive([]string{"base_000000010000000100000002"},
+				"0000000100000000000000FF",
+				"000000010000000100000002",
+				"000000010000000100000002.00000028.backup",
+			),
+			start: "1/2000000",
+			end:   "1/3000000",
+		},
+		{
+			name: "oldest full base backup",
+			items: archive([]string{"base_000000010000000000000002", "base_000000010000000100000000"},
+				"000000010000000000000002",
+				"000000010000000100000000",
+			),
+			start: "0/2000000",
+			end:   "1/1000000",
+		},
+		{
+			name:  "base backup name without start segment",
+			items: archive([]string{"base_unknown"}, "000000010000000000000002"),
+			start: "0/0",
+			end:   "0/0",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			wantStart, err := le.ParseLSN(c.start)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			wantEnd, err := le.ParseLSN(c.end)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			start, end := recoveryLSNWindow(testArchivePrefix, c.items)
+			if start != wantStart || end != wantEnd {
+				t.Errorf("expected window %s - %s, got %X/%X - %X/%X", c.start, c.end, start>>32, uint32(start), end>>32, uint32(end))
+			}
+		})
+	}
+}
+
+// TestRecoveryLSNWindowEdges checks target LSNs against the window, as the recovery target of a clone is checked.
+func TestRecoveryLSNWindowEdges(t *testing.T) {
+	items := append(backupItems("base_0000000100000000000000FE", time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)),
+		walItems(time.Date(2019, 3, 1, 1, 0, 0, 0, time.UTC),
+			"0000000100000000000000FE",
+			"0000000100000000000000FF",
+			"000000010000000100000000",
+			"000000010000000100000001",
+		)...)
+	start, end := recoveryLSNWindow(testArchivePrefix, items)
+
+	cases := []struct {
+		target string
+		inside bool
+	}{
+		{"0/FDFFFFFF", false},
+		{"0/FE000000", true},
+		{"0/FFFFFFFF", true},
+		{"1/0", true},
+		{"1/1FFFFFF", true},
+		{"1/2000000", false},
+		// the lo part alone is inside the window
+		{"2/FE000000", false},
+		{"0/1000000", false},
+	}
+	for _, c := range cases {
+		t.Run(c.target, func(t *testing.T) {
+			lsn, err := le.ParseLSN(c.target)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if inside := lsn >= start && lsn < end; inside != c.inside {
+				t.Errorf("expected %s inside the window %v, got %v", c.target, c.inside, inside)
+			}
+		})
+	}
+}
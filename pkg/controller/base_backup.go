@@ -0,0 +1,199 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	"kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1/util"
+	le "kubedb.dev/postgres/pkg/leader_election"
+
+	"github.com/appscode/go/log"
+	cron "github.com/robfig/cron/v3"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+const (
+	EventReasonBaseBackupScheduled = "BaseBackupScheduled"
+
+	// period at which the Postgres objects are checked for due and pending base backups
+	baseBackupSyncPeriod = time.Minute
+	// a base backup fails, if it is not started by the member within this duration
+	baseBackupTimeout = 5 * time.Minute
+)
+
+// ensureBaseBackup schedules a base backup of postgres, when it is due by spec.archiver.baseBackup.schedule.
+// The base backup is recorded as Pending in status, along with the member that pushes it. The leader election
+// sidecar of that member runs wal-g backup-push, deletes the base backups beyond spec.archiver.baseBackup.retain
+// and records the remaining ones in status.
+func (c *Controller) ensureBaseBackup(postgres *api.Postgres) error {
+	if postgres.Spec.Archiver == nil || postgres.Spec.Archiver.BaseBackup == nil {
+		return nil
+	}
+
+	if backup := postgres.Status.BaseBackup; backup != nil {
+		switch backup.Phase {
+		case api.BaseBackupPhasePending:
+			if time.Since(backup.LastTransitionTime.Time) > baseBackupTimeout {
+				return c.updateBaseBackup(postgres, api.BaseBackupPhaseFailed, backup.Member,
+					fmt.Sprintf("base backup was not started by %s within %v", backup.Member, baseBackupTimeout))
+			}
+			return nil
+		case api.BaseBackupPhaseRunning:
+			if !memberReady(postgres, backup.Member) {
+				return c.updateBaseBackup(postgres, api.BaseBackupPhaseFailed, backup.Member,
+					fmt.Sprintf("%s became unavailable while pushing the base backup", backup.Member))
+			}
+			return nil
+		}
+	}
+
+	if !baseBackupDue(postgres, time.Now()) {
+		return nil
+	}
+	// the base backup is retried by syncBaseBackups, once a member is ready
+	member := baseBackupMember(postgres)
+	if member == "" {
+		log.Infof("base backup of Postgres %s/%s is deferred, as no member is ready", postgres.Namespace, postgres.Name)
+		return nil
+	}
+
+	if err := c.updateBaseBackup(postgres, api.BaseBackupPhasePending, member, fmt.Sprintf("base backup is scheduled on %s", member)); err != nil {
+		return err
+	}
+	c.recorder.Eventf(
+		postgres,
+		core.EventTypeNormal,
+		EventReasonBaseBackupScheduled,
+		"Base backup is scheduled on %s",
+		member,
+	)
+	return nil
+}
+
+// baseBackupDue returns true, if a base backup is scheduled by spec.archiver.baseBackup.schedule since the
+// last successful one. A failed base backup is retried at the next scheduled time.
+func baseBackupDue(postgres *api.Postgres, now time.Time) bool {
+	if postgres.Spec.Archiver == nil || postgres.Spec.Archiver.BaseBackup == nil {
+		return false
+	}
+	schedule, err := cron.ParseStandard(postgres.Spec.Archiver.BaseBackup.Schedule)
+	if err != nil {
+		return false
+	}
+	last := postgres.CreationTimestamp.Time
+	if backup := postgres.Status.BaseBackup; backup != nil {
+		if backup.LastBackupTime != nil {
+			last = backup.LastBackupTime.Time
+		}
+		if backup.Phase == api.BaseBackupPhaseFailed && backup.LastTransitionTime.After(last) {
+			last = backup.LastTransitionTime.Time
+		}
+	}
+	return !schedule.Next(last).After(now)
+}
+
+// baseBackupMember returns the member that pushes the next base backup. The ready replica with the least lag
+// is preferred, so that the primary is not loaded with it. The primary is used, if no replica is ready.
+func baseBackupMember(postgres *api.Postgres) string {
+	var replica *api.PostgresMemberStatus
+	primary := ""
+	for i := range postgres.Status.Members {
+		m := &postgres.Status.Members[i]
+		if !m.Ready {
+			continue
+		}
+		if m.Role == le.RolePrimary {
+			primary = m.Name
+			continue
+		}
+		if replica == nil || lagBytes(m) < lagBytes(replica) {
+			replica = m
+		}
+	}
+	if replica != nil {
+		return replica.Name
+	}
+	return primary
+}
+
+func lagBytes(m *api.PostgresMemberStatus) int64 {
+	if m.LagBytes == nil {
+		// unknown lag is ranked behind every known one
+		return 1<<63 - 1
+	}
+	return *m.LagBytes
+}
+
+func memberReady(postgres *api.Postgres, name string) bool {
+	for _, m := range postgres.Status.Members {
+		if m.Name == name {
+			return m.Ready
+		}
+	}
+	return false
+}
+
+// updateBaseBackup records the progress of the base backup in the status of postgres. The base backups in
+// the archive, recorded by the sidecar, are kept.
+func (c *Controller) updateBaseBackup(postgres *api.Postgres, phase api.BaseBackupPhase, member, reason string) error {
+	if phase == api.BaseBackupPhaseFailed {
+		c.recorder.Event(postgres, core.EventTypeWarning, le.EventReasonBaseBackupFailed, reason)
+	}
+	pg, err := util.UpdatePostgresStatus(c.ExtClient.KubedbV1alpha1(), postgres, func(in *api.PostgresStatus) *api.PostgresStatus {
+		if in.BaseBackup == nil {
+			in.BaseBackup = &api.PostgresBaseBackupStatus{}
+		}
+		in.BaseBackup.Phase = phase
+		in.BaseBackup.Reason = reason
+		in.BaseBackup.Member = member
+		in.BaseBackup.LastTransitionTime = metav1.Now()
+		return in
+	})
+	if err != nil {
+		return err
+	}
+	postgres.Status = pg.Status
+	return nil
+}
+
+// syncBaseBackups processes the Postgres objects again, whose base backup is in progress or due by schedule.
+func (c *Controller) syncBaseBackups() {
+	dbs, err := c.pgLister.List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	now := time.Now()
+	for _, postgres := range dbs {
+		if postgres.DeletionTimestamp != nil || postgres.Spec.Archiver == nil || postgres.Spec.Archiver.BaseBackup == nil {
+			continue
+		}
+		if backup := postgres.Status.BaseBackup; backup != nil &&
+			(backup.Phase == api.BaseBackupPhasePending || backup.Phase == api.BaseBackupPhaseRunning) {
+			queue.Enqueue(c.pgQueue.GetQueue(), postgres)
+			continue
+		}
+		if baseBackupDue(postgres, now) {
+			queue.Enqueue(c.pgQueue.GetQueue(), postgres)
+		}
+	}
+}
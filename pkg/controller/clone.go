@@ -0,0 +1,518 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	"kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1/util"
+	le "kubedb.dev/postgres/pkg/leader_election"
+
+	"github.com/appscode/go/types"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientsetscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/reference"
+	core_util "kmodules.xyz/client-go/core/v1"
+)
+
+const (
+	EventReasonCloneStarted   = "CloneStarted"
+	EventReasonCloneSucceeded = "CloneSucceeded"
+	EventReasonCloneFailed    = "CloneFailed"
+
+	// directory that the clone secret is mounted at, see cloneSecretName
+	cloneSecretDir = "/srv/postgres/clone/secrets"
+	// key of the clone secret with the method that the data is copied with
+	cloneMethodKey = "CLONE_METHOD"
+)
+
+// cloneSecretName is the secret with the credentials, that the primary copies the data of the source with.
+// It holds the superuser and the replication client certificate of the source for a base backup, and the
+// storage credentials and the wal-g prefixes of the archive of the source for a restore.
+func cloneSecretName(postgres *api.Postgres) string {
+	return postgres.OffshootName() + "-clone"
+}
+
+// cloneEncryptionSecretName is the secret with the encryption keys of the archive of the source, that the
+// primary decrypts the archive with. Both secrets are deleted, once the clone has completed.
+func cloneEncryptionSecretName(postgres *api.Postgres) string {
+	return postgres.OffshootName() + "-clone-encryption"
+}
+
+// cloneSource returns the namespace and name of the Postgres, that postgres is cloned from.
+func cloneSource(postgres *api.Postgres) (string, string) {
+	clone := postgres.Spec.Init.PostgresClone
+	if clone.Namespace == "" {
+		return postgres.Namespace, clone.Name
+	}
+	return clone.Namespace, clone.Name
+}
+
+// cloneMethod returns the method to copy the data of source with. An archive in a local volume can not be
+// mounted in another Postgres, so it is cloned with a base backup instead, unless a point in time is recovered.
+func cloneMethod(clone *api.PostgresCloneSourceSpec, source *api.Postgres) api.PostgresCloneMethod {
+	if clone.Method != "" {
+		return clone.Method
+	}
+	if clone.PITR != nil || archiveRestorable(source) {
+		return api.PostgresCloneMethodArchive
+	}
+	return api.PostgresCloneMethodBaseBackup
+}
+
+func archiveRestorable(source *api.Postgres) bool {
+	return source.Spec.Archiver != nil && source.Spec.Archiver.Storage != nil && source.Spec.Archiver.Storage.Local == nil
+}
+
+// ensureClone prepares the clone secret of postgres, before its primary is started with an empty data
+// directory, and records the progress in status. The image of the primary copies the data of the source,
+// and sets the superuser password of postgres, before postgres is started. The clone completes once the
+// primary is ready, see completeClone.
+func (c *Controller) ensureClone(postgres *api.Postgres) error {
+	if postgres.Spec.Init == nil || postgres.Spec.Init.PostgresClone == nil {
+		return nil
+	}
+	if status := postgres.Status.Clone; status != nil &&
+		(status.Phase == api.PostgresClonePhaseSucceeded || status.Phase == api.PostgresClonePhaseFailed) {
+		return nil
+	}
+
+	clone := postgres.Spec.Init.PostgresClone
+	namespace, name := cloneSource(postgres)
+	source, dormant, err := c.cloneSourcePostgres(namespace, name)
+	if kerr.IsNotFound(err) {
+		return c.failClone(postgres, "", fmt.Sprintf("source Postgres %s/%s not found", namespace, name))
+	} else if err != nil {
+		return err
+	}
+
+	method := cloneMethod(clone, source)
+	var data map[string][]byte
+	switch method {
+	case api.PostgresCloneMethodArchive:
+		if !archiveRestorable(source) {
+			return c.failClone(postgres, method, fmt.Sprintf("source Postgres %s/%s does not archive WAL to a cloud storage", namespace, name))
+		}
+		// the target is checked only once, as the archive of a running source keeps growing during the restore
+		if postgres.Status.Clone == nil || postgres.Status.Clone.Phase != api.PostgresClonePhaseRunning {
+			if reason, err := c.checkRecoveryTarget(source, clone.PITR); err != nil {
+				return fmt.Errorf("failed to read the archive of source Postgres %s/%s. Reason: %v", namespace, name, err)
+			} else if reason != "" {
+				return c.failClone(postgres, method, reason)
+			}
+			if reason, err := c.checkCloneDecryption(source); err != nil {
+				return fmt.Errorf("failed to read the archive of source Postgres %s/%s. Reason: %v", namespace, name, err)
+			} else if reason != "" {
+				return c.failClone(postgres, method, reason)
+			}
+		}
+		if err := c.ensureCloneEncryption(postgres, source); err != nil {
+			return fmt.Errorf("failed to read the encryption keys of source Postgres %s/%s. Reason: %v", namespace, name, err)
+		}
+		data, err = c.cloneArchiveCredentials(source, clone.PITR)
+	case api.PostgresCloneMethodBaseBackup:
+		if dormant {
+			return c.failClone(postgres, method, fmt.Sprintf("source Postgres %s/%s is deleted, only its archive can be restored", namespace, name))
+		}
+		if clone.PITR != nil {
+			return c.failClone(postgres, method, "a point in time can only be recovered with the Archive method")
+		}
+		if source.Status.Phase != api.DatabasePhaseRunning {
+			return fmt.Errorf("source Postgres %s/%s is not running", namespace, name)
+		}
+		data, err = c.cloneBaseBackupCredentials(source)
+	default:
+		return c.failClone(postgres, method, fmt.Sprintf("clone method %q is not supported", method))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read the credentials of source Postgres %s/%s. Reason: %v", namespace, name, err)
+	}
+	data[cloneMethodKey] = []byte(method)
+
+	ref, err := reference.GetReference(clientsetscheme.Scheme, postgres)
+	if err != nil {
+		return err
+	}
+	meta := metav1.ObjectMeta{
+		Name:      cloneSecretName(postgres),
+		Namespace: postgres.Namespace,
+	}
+	if _, _, err := core_util.CreateOrPatchSecret(c.Client, meta, func(in *core.Secret) *core.Secret {
+		in.Labels = postgres.OffshootLabels()
+		core_util.EnsureOwnerReference(&in.ObjectMeta, ref)
+		in.Data = data
+		return in
+	}); err != nil {
+		return err
+	}
+
+	if postgres.Status.Clone != nil && postgres.Status.Clone.Phase == api.PostgresClonePhaseRunning {
+		return nil
+	}
+	c.recorder.Eventf(
+		postgres,
+		core.EventTypeNormal,
+		EventReasonCloneStarted,
+		"Cloning Postgres %s/%s with method %s%s",
+		namespace, name, method, recoveryTargetMessage(clone.PITR),
+	)
+	return c.updateClone(postgres, method, api.PostgresClonePhaseRunning, "")
+}
+
+// recoveryTargetMessage describes pitr for the events of a clone.
+func recoveryTargetMessage(pitr *api.RecoveryTarget) string {
+	if pitr == nil {
+		return ""
+	}
+	switch {
+	case pitr.TargetTime != "":
+		return fmt.Sprintf(" to time %s", pitr.TargetTime)
+	case pitr.TargetLSN != "":
+		return fmt.Sprintf(" to LSN %s", pitr.TargetLSN)
+	case pitr.TargetName != "":
+		return fmt.Sprintf(" to restore point %s", pitr.TargetName)
+	case pitr.TargetXID != "":
+		return fmt.Sprintf(" to transaction %s", pitr.TargetXID)
+	}
+	return ""
+}
+
+// cloneSourcePostgres returns the Postgres namespace/name. If it is deleted, the Postgres is taken from its
+// DormantDatabase, and dormant is true.
+func (c *Controller) cloneSourcePostgres(namespace, name string) (*api.Postgres, bool, error) {
+	source, err := c.ExtClient.KubedbV1alpha1().Postgreses(namespace).Get(name, metav1.GetOptions{})
+	if err == nil || !kerr.IsNotFound(err) {
+		return source, false, err
+	}
+	drmn, err := c.ExtClient.KubedbV1alpha1().DormantDatabases(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	if drmn.Spec.Origin.Spec.Postgres == nil {
+		return nil, false, kerr.NewNotFound(api.Resource(api.ResourceSingularPostgres), name)
+	}
+	return &api.Postgres{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      drmn.Name,
+			Namespace: drmn.Namespace,
+		},
+		Spec: *drmn.Spec.Origin.Spec.Postgres,
+	}, true, nil
+}
+
+// checkRecoveryTarget returns the reason, that pitr can not be recovered from the archive of source. Without
+// pitr, the archive is recovered to its end, and only needs a complete full base backup. Targets other than
+// targetTime and targetLSN are rejected by the validator, as the archive does not record them.
+func (c *Controller) checkRecoveryTarget(source *api.Postgres, pitr *api.RecoveryTarget) (string, error) {
+	items, err := c.listArchive(source)
+	if err != nil {
+		return "", err
+	}
+	prefix := WalDataDir(source)
+	earliest, latest := recoveryWindow(prefix, items)
+	if earliest.IsZero() {
+		return fmt.Sprintf("archive of source Postgres %s/%s has no complete base backup", source.Namespace, source.Name), nil
+	}
+	if pitr == nil {
+		return "", nil
+	}
+
+	if pitr.TargetTime != "" {
+		t, err := pitr.Time()
+		if err != nil {
+			return err.Error(), nil
+		}
+		if t.Before(earliest) || t.After(latest) {
+			return fmt.Sprintf("targetTime %s is outside of the recoverability window %s - %s of source Postgres %s/%s",
+				pitr.TargetTime, earliest.UTC().Format(time.RFC3339), latest.UTC().Format(time.RFC3339), source.Namespace, source.Name), nil
+		}
+	}
+	if pitr.TargetLSN != "" {
+		lsn, err := le.ParseLSN(pitr.TargetLSN)
+		if err != nil {
+			return fmt.Sprintf("targetLSN is invalid. Reason: %v", err), nil
+		}
+		start, end := recoveryLSNWindow(prefix, items)
+		if lsn < start || lsn >= end {
+			return fmt.Sprintf("targetLSN %s is outside of the WAL archived by source Postgres %s/%s", pitr.TargetLSN, source.Namespace, source.Name), nil
+		}
+	}
+	return "", nil
+}
+
+// cloneBaseBackupCredentials returns the superuser of source, and its replication client certificate if
+// TLS is enabled, to stream its data directory with.
+func (c *Controller) cloneBaseBackupCredentials(source *api.Postgres) (map[string][]byte, error) {
+	if source.Spec.DatabaseSecret == nil {
+		return nil, fmt.Errorf("database secret is not set")
+	}
+	secret, err := c.Client.CoreV1().Secrets(source.Namespace).Get(source.Spec.DatabaseSecret.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	data := map[string][]byte{
+		PostgresUser:     secret.Data[PostgresUser],
+		PostgresPassword: secret.Data[PostgresPassword],
+	}
+	if source.Spec.TLS == nil {
+		return data, nil
+	}
+
+	client, err := c.Client.CoreV1().Secrets(source.Namespace).Get(replicationCertSecretName(source), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ca, err := c.caBundle(source)
+	if err != nil {
+		return nil, err
+	}
+	data[le.TLSCert] = client.Data[core.TLSCertKey]
+	data[le.TLSKey] = client.Data[core.TLSPrivateKeyKey]
+	data[le.TLSCA] = ca
+	return data, nil
+}
+
+// cloneArchiveCredentials returns the storage credentials of the archive of source, together with the prefixes
+// that wal-g restores it from and the recovery target if any.
+func (c *Controller) cloneArchiveCredentials(source *api.Postgres, pitr *api.RecoveryTarget) (map[string][]byte, error) {
+	storage := source.Spec.Archiver.Storage
+	secret, err := c.Client.CoreV1().Secrets(source.Namespace).Get(storage.StorageSecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	data := map[string][]byte{}
+	for k, v := range secret.Data {
+		data[k] = v
+	}
+	wal := archiveSource(source)
+	wal.PITR = pitr
+	for _, env := range walRecoveryConfig(wal) {
+		data[env.Name] = []byte(env.Value)
+	}
+	return data, nil
+}
+
+// checkCloneDecryption returns the reason, why the archive of source can not be decrypted with the keys of its
+// spec.archiver.encryption.
+func (c *Controller) checkCloneDecryption(source *api.Postgres) (string, error) {
+	container, err := c.archiveContainer(source)
+	if err != nil {
+		return "", err
+	}
+	return c.checkArchiveDecryption(container, WalDataDir(source), source.Namespace, source.Spec.Archiver.Encryption)
+}
+
+// ensureCloneEncryption copies the encryption keys of the archive of source to the clone encryption secret of
+// postgres, as the secret of source may be in another namespace.
+func (c *Controller) ensureCloneEncryption(postgres, source *api.Postgres) error {
+	encryption := source.Spec.Archiver.Encryption
+	if encryption == nil {
+		return nil
+	}
+	keys, err := c.encryptionKeys(source.Namespace, encryption)
+	if err != nil {
+		return err
+	}
+	ref, err := reference.GetReference(clientsetscheme.Scheme, postgres)
+	if err != nil {
+		return err
+	}
+	meta := metav1.ObjectMeta{
+		Name:      cloneEncryptionSecretName(postgres),
+		Namespace: postgres.Namespace,
+	}
+	_, _, err = core_util.CreateOrPatchSecret(c.Client, meta, func(in *core.Secret) *core.Secret {
+		in.Labels = postgres.OffshootLabels()
+		core_util.EnsureOwnerReference(&in.ObjectMeta, ref)
+		in.Data = keys
+		return in
+	})
+	return err
+}
+
+// archiveSource returns the location that the members of source archive their base backups and WAL to.
+func archiveSource(source *api.Postgres) *api.PostgresWALSourceSpec {
+	wal := &api.PostgresWALSourceSpec{
+		Backend:    *source.Spec.Archiver.Storage.DeepCopy(),
+		Encryption: source.Spec.Archiver.Encryption.DeepCopy(),
+	}
+	prefix := WalDataDir(source)
+	if wal.S3 != nil {
+		wal.S3.Prefix = prefix
+	} else if wal.GCS != nil {
+		wal.GCS.Prefix = prefix
+	} else if wal.Azure != nil {
+		wal.Azure.Prefix = prefix
+	} else if wal.Swift != nil {
+		wal.Swift.Prefix = prefix
+	}
+	return wal
+}
+
+// completeClone records the clone of postgres as succeeded, once its primary is ready, and deletes the clone secrets.
+func (c *Controller) completeClone(postgres *api.Postgres) error {
+	status := postgres.Status.Clone
+	if status == nil || status.Phase != api.PostgresClonePhaseRunning {
+		return nil
+	}
+	statefulSet, err := c.Client.AppsV1().StatefulSets(postgres.Namespace).Get(postgres.OffshootName(), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if statefulSet.Status.ReadyReplicas == 0 {
+		return nil
+	}
+	if err := c.deleteCloneSecrets(postgres); err != nil {
+		return err
+	}
+	namespace, name := cloneSource(postgres)
+	c.recorder.Eventf(
+		postgres,
+		core.EventTypeNormal,
+		EventReasonCloneSucceeded,
+		"Successfully cloned Postgres %s/%s",
+		namespace, name,
+	)
+	return c.updateClone(postgres, status.Method, api.PostgresClonePhaseSucceeded, "")
+}
+
+func (c *Controller) failClone(postgres *api.Postgres, method api.PostgresCloneMethod, reason string) error {
+	if err := c.deleteCloneSecrets(postgres); err != nil {
+		return err
+	}
+	c.recorder.Event(postgres, core.EventTypeWarning, EventReasonCloneFailed, reason)
+	return c.updateClone(postgres, method, api.PostgresClonePhaseFailed, reason)
+}
+
+// deleteCloneSecrets deletes the credentials and the encryption keys of the source of postgres, once the clone has
+// completed. They are deleted before the clone is recorded as completed, so that a failed deletion is retried.
+// The members mount the secrets as optional, and are restarted with their data.
+func (c *Controller) deleteCloneSecrets(postgres *api.Postgres) error {
+	for _, name := range []string{cloneSecretName(postgres), cloneEncryptionSecretName(postgres)} {
+		err := c.Client.CoreV1().Secrets(postgres.Namespace).Delete(name, &metav1.DeleteOptions{})
+		if err != nil && !kerr.IsNotFound(err) {
+			return fmt.Errorf("failed to delete clone secret %s. Reason: %v", name, err)
+		}
+	}
+	return nil
+}
+
+func (c *Controller) updateClone(postgres *api.Postgres, method api.PostgresCloneMethod, phase api.PostgresClonePhase, reason string) error {
+	pg, err := util.UpdatePostgresStatus(c.ExtClient.KubedbV1alpha1(), postgres, func(in *api.PostgresStatus) *api.PostgresStatus {
+		if in.Clone == nil {
+			in.Clone = &api.PostgresCloneStatus{}
+		}
+		now := metav1.Now()
+		in.Clone.Method = method
+		in.Clone.Phase = phase
+		in.Clone.Reason = reason
+		in.Clone.LastTransitionTime = now
+		if phase == api.PostgresClonePhaseFailed {
+			in.Phase = api.DatabasePhaseFailed
+			in.Reason = reason
+		}
+		switch phase {
+		case api.PostgresClonePhaseRunning:
+			in.Clone.StartTime = &now
+		case api.PostgresClonePhaseSucceeded, api.PostgresClonePhaseFailed:
+			in.Clone.CompletionTime = &now
+		}
+		return in
+	})
+	if err != nil {
+		return err
+	}
+	postgres.Status = pg.Status
+	return nil
+}
+
+// cloneConfig returns the environment, that the primary finds the source of postgres with. The primary
+// streams the data from the replicas of the source, and from its primary if the source has no replicas.
+func cloneConfig(postgres *api.Postgres) []core.EnvVar {
+	namespace, name := cloneSource(postgres)
+	source := api.Postgres{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	return []core.EnvVar{
+		{
+			Name:  "CLONE",
+			Value: "true",
+		},
+		{
+			Name:  "CLONE_HOST",
+			Value: fmt.Sprintf("%s.%s.svc", source.ServiceName(), namespace),
+		},
+		{
+			Name:  "CLONE_REPLICAS_HOST",
+			Value: fmt.Sprintf("%s.%s.svc", source.ReplicasServiceName(), namespace),
+		},
+	}
+}
+
+// upsertCloneSecret mounts the clone secret of postgres. An archive of the source is restored with the
+// credentials and the encryption keys at the paths, that the image restores spec.init.postgresWAL with.
+func upsertCloneSecret(statefulSet *apps.StatefulSet, postgres *api.Postgres) *apps.StatefulSet {
+	podSpec := &statefulSet.Spec.Template.Spec
+	for i, container := range podSpec.Containers {
+		if container.Name == api.ResourceSingularPostgres {
+			volumeMounts := core_util.UpsertVolumeMountByPath(container.VolumeMounts, core.VolumeMount{
+				Name:      "clone",
+				MountPath: cloneSecretDir,
+				ReadOnly:  true,
+			})
+			podSpec.Containers[i].VolumeMounts = core_util.UpsertVolumeMountByPath(volumeMounts, core.VolumeMount{
+				Name:      "clone",
+				MountPath: "/srv/wal-g/restore/secrets",
+				ReadOnly:  true,
+			})
+			podSpec.Volumes = core_util.UpsertVolume(podSpec.Volumes, core.Volume{
+				Name: "clone",
+				VolumeSource: core.VolumeSource{
+					Secret: &core.SecretVolumeSource{
+						SecretName: cloneSecretName(postgres),
+						// members are restarted with their data, after the source is gone
+						Optional: types.BoolP(true),
+					},
+				},
+			})
+			podSpec.Containers[i].VolumeMounts = core_util.UpsertVolumeMountByPath(podSpec.Containers[i].VolumeMounts, core.VolumeMount{
+				Name:      "clone-encryption",
+				MountPath: "/srv/wal-g/restore/encryption",
+				ReadOnly:  true,
+			})
+			podSpec.Volumes = core_util.UpsertVolume(podSpec.Volumes, core.Volume{
+				Name: "clone-encryption",
+				VolumeSource: core.VolumeSource{
+					Secret: &core.SecretVolumeSource{
+						SecretName: cloneEncryptionSecretName(postgres),
+						// the archive of the source is not encrypted
+						Optional: types.BoolP(true),
+					},
+				},
+			})
+			break
+		}
+	}
+	return statefulSet
+}
@@ -0,0 +1,233 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"testing"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	store "kmodules.xyz/objectstore-api/api/v1"
+)
+
+// newClonePostgres returns a Postgres named foo, that is cloned from the Postgres source of namespace prod.
+func newClonePostgres(source string, method api.PostgresCloneMethod) *api.Postgres {
+	return &api.Postgres{
+		// the secrets of postgres are owned by it, which requires its kind
+		TypeMeta:   metav1.TypeMeta{Kind: api.ResourceKindPostgres, APIVersion: api.SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+		Spec: api.PostgresSpec{
+			Init: &api.InitSpec{
+				PostgresClone: &api.PostgresCloneSourceSpec{Name: source, Namespace: "prod", Method: method},
+			},
+		},
+	}
+}
+
+func TestCloneSource(t *testing.T) {
+	postgres := newClonePostgres("bar", "")
+	if namespace, name := cloneSource(postgres); namespace != "prod" || name != "bar" {
+		t.Errorf("expected source prod/bar, got %s/%s", namespace, name)
+	}
+	postgres.Spec.Init.PostgresClone.Namespace = ""
+	if namespace, name := cloneSource(postgres); namespace != "default" || name != "bar" {
+		t.Errorf("expected source default/bar, got %s/%s", namespace, name)
+	}
+}
+
+func TestCloneMethod(t *testing.T) {
+	cloud := &api.PostgresArchiverSpec{Storage: &store.Backend{S3: &store.S3Spec{Bucket: "archive"}}}
+	local := &api.PostgresArchiverSpec{Storage: &store.Backend{Local: &store.LocalSpec{MountPath: "/archive"}}}
+	cases := []struct {
+		name     string
+		method   api.PostgresCloneMethod
+		pitr     *api.RecoveryTarget
+		archiver *api.PostgresArchiverSpec
+		want     api.PostgresCloneMethod
+	}{
+		{"without archive", "", nil, nil, api.PostgresCloneMethodBaseBackup},
+		{"cloud archive", "", nil, cloud, api.PostgresCloneMethodArchive},
+		{"local archive", "", nil, local, api.PostgresCloneMethodBaseBackup},
+		{"point in time", "", &api.RecoveryTarget{TargetLSN: "0/3000060"}, nil, api.PostgresCloneMethodArchive},
+		{"requested", api.PostgresCloneMethodBaseBackup, nil, cloud, api.PostgresCloneMethodBaseBackup},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			clone := &api.PostgresCloneSourceSpec{Name: "bar", Method: c.method, PITR: c.pitr}
+			source := &api.Postgres{Spec: api.PostgresSpec{Archiver: c.archiver}}
+			if got := cloneMethod(clone, source); got != c.want {
+				t.Errorf("expected method %s, got %s", c.want, got)
+			}
+		})
+	}
+}
+
+func TestArchiveSource(t *testing.T) {
+	source := &api.Postgres{
+		ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "prod"},
+		Spec: api.PostgresSpec{
+			Archiver: &api.PostgresArchiverSpec{
+				Storage:    &store.Backend{StorageSecretName: "s3-secret", S3: &store.S3Spec{Bucket: "archive", Prefix: "team"}},
+				Encryption: &api.BackupEncryptionSpec{SecretName: "keys", KeyID: "2020"},
+			},
+		},
+	}
+	wal := archiveSource(source)
+	if want := "team/kubedb/prod/bar/archive"; wal.S3.Prefix != want {
+		t.Errorf("expected prefix %s, got %s", want, wal.S3.Prefix)
+	}
+	if wal.StorageSecretName != "s3-secret" || wal.Encryption == nil || wal.Encryption.KeyID != "2020" {
+		t.Errorf("expected the storage secret and the encryption of the source, got %+v", wal)
+	}
+	if source.Spec.Archiver.Storage.S3.Prefix != "team" {
+		t.Errorf("expected the archiver of the source to be unchanged, got prefix %s", source.Spec.Archiver.Storage.S3.Prefix)
+	}
+}
+
+func TestRecoveryTargetMessage(t *testing.T) {
+	cases := []struct {
+		name string
+		pitr *api.RecoveryTarget
+		want string
+	}{
+		{"none", nil, ""},
+		{"time", &api.RecoveryTarget{TargetTime: "2020-01-02 15:04:05+00"}, " to time 2020-01-02 15:04:05+00"},
+		{"lsn", &api.RecoveryTarget{TargetLSN: "0/3000060"}, " to LSN 0/3000060"},
+		{"restore point", &api.RecoveryTarget{TargetName: "before-migration"}, " to restore point before-migration"},
+		{"transaction", &api.RecoveryTarget{TargetXID: "1234"}, " to transaction 1234"},
+		{"timeline only", &api.RecoveryTarget{TargetTimeline: "2"}, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := recoveryTargetMessage(c.pitr); got != c.want {
+				t.Errorf("expected %q, got %q", c.want, got)
+			}
+		})
+	}
+}
+
+// TestEnsureClone checks the progress of a clone from a source without archive, that is copied with a base backup.
+func TestEnsureClone(t *testing.T) {
+	source := &api.Postgres{
+		ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "prod"},
+		Spec:       api.PostgresSpec{DatabaseSecret: &core.SecretVolumeSource{SecretName: "bar-auth"}},
+		Status:     api.PostgresStatus{Phase: api.DatabasePhaseRunning},
+	}
+	sourceSecret := &core.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "bar-auth", Namespace: "prod"},
+		Data:       map[string][]byte{PostgresUser: []byte("postgres"), PostgresPassword: []byte("secret")},
+	}
+	dormant := &api.DormantDatabase{
+		ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "prod"},
+		Spec: api.DormantDatabaseSpec{
+			Origin: api.Origin{Spec: api.OriginSpec{Postgres: &source.Spec}},
+		},
+	}
+
+	t.Run("running", func(t *testing.T) {
+		postgres := newClonePostgres("bar", "")
+		ctrl := newTestController([]runtime.Object{sourceSecret}, postgres, source)
+		if err := ctrl.ensureClone(postgres); err != nil {
+			t.Fatal(err)
+		}
+		if status := postgres.Status.Clone; status == nil || status.Phase != api.PostgresClonePhaseRunning ||
+			status.Method != api.PostgresCloneMethodBaseBackup {
+			t.Fatalf("expected clone Running with method %s, got %+v", api.PostgresCloneMethodBaseBackup, status)
+		}
+		secret, err := ctrl.Client.CoreV1().Secrets("default").Get(cloneSecretName(postgres), metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(secret.Data[PostgresPassword]) != "secret" || string(secret.Data[cloneMethodKey]) != string(api.PostgresCloneMethodBaseBackup) {
+			t.Errorf("expected the credentials of the source and the method in the clone secret, got %v", secret.Data)
+		}
+	})
+
+	t.Run("dormant source", func(t *testing.T) {
+		postgres := newClonePostgres("bar", api.PostgresCloneMethodBaseBackup)
+		ctrl := newTestController(nil, postgres, dormant)
+		if err := ctrl.ensureClone(postgres); err != nil {
+			t.Fatal(err)
+		}
+		if status := postgres.Status.Clone; status == nil || status.Phase != api.PostgresClonePhaseFailed {
+			t.Fatalf("expected clone Failed, got %+v", status)
+		}
+		if postgres.Status.Phase != api.DatabasePhaseFailed {
+			t.Errorf("expected Postgres phase %s, got %s", api.DatabasePhaseFailed, postgres.Status.Phase)
+		}
+	})
+
+	t.Run("source not found", func(t *testing.T) {
+		postgres := newClonePostgres("bar", "")
+		ctrl := newTestController(nil, postgres)
+		if err := ctrl.ensureClone(postgres); err != nil {
+			t.Fatal(err)
+		}
+		if status := postgres.Status.Clone; status == nil || status.Phase != api.PostgresClonePhaseFailed {
+			t.Fatalf("expected clone Failed, got %+v", status)
+		}
+	})
+
+	t.Run("succeeded", func(t *testing.T) {
+		postgres := newClonePostgres("bar", "")
+		postgres.Status.Clone = &api.PostgresCloneStatus{Method: api.PostgresCloneMethodBaseBackup, Phase: api.PostgresClonePhaseRunning}
+		statefulSet := &apps.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: postgres.OffshootName(), Namespace: "default"},
+		}
+		secrets := []runtime.Object{
+			&core.Secret{ObjectMeta: metav1.ObjectMeta{Name: cloneSecretName(postgres), Namespace: "default"}},
+			&core.Secret{ObjectMeta: metav1.ObjectMeta{Name: cloneEncryptionSecretName(postgres), Namespace: "default"}},
+		}
+		ctrl := newTestController(append(secrets, statefulSet), postgres, source)
+
+		// the clone is running until the primary is ready
+		if err := ctrl.completeClone(postgres); err != nil {
+			t.Fatal(err)
+		}
+		if postgres.Status.Clone.Phase != api.PostgresClonePhaseRunning {
+			t.Fatalf("expected clone Running before the primary is ready, got %s", postgres.Status.Clone.Phase)
+		}
+
+		statefulSet.Status.ReadyReplicas = 1
+		if _, err := ctrl.Client.AppsV1().StatefulSets("default").UpdateStatus(statefulSet); err != nil {
+			t.Fatal(err)
+		}
+		if err := ctrl.completeClone(postgres); err != nil {
+			t.Fatal(err)
+		}
+		if status := postgres.Status.Clone; status.Phase != api.PostgresClonePhaseSucceeded || status.CompletionTime == nil {
+			t.Fatalf("expected clone Succeeded with completion time, got %+v", status)
+		}
+		for _, name := range []string{cloneSecretName(postgres), cloneEncryptionSecretName(postgres)} {
+			if _, err := ctrl.Client.CoreV1().Secrets("default").Get(name, metav1.GetOptions{}); !kerr.IsNotFound(err) {
+				t.Errorf("expected secret %s to be deleted, got %v", name, err)
+			}
+		}
+
+		// a completed clone is not processed again
+		if err := ctrl.ensureClone(postgres); err != nil {
+			t.Fatal(err)
+		}
+		if postgres.Status.Clone.Phase != api.PostgresClonePhaseSucceeded {
+			t.Errorf("expected clone to stay Succeeded, got %s", postgres.Status.Clone.Phase)
+		}
+	})
+}
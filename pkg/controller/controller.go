@@ -35,6 +35,7 @@ import (
 	crd_cs "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1beta1"
 	"k8s.io/apimachinery/pkg/labels"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -66,6 +67,21 @@ type Controller struct {
 	pgQueue    *queue.Worker
 	pgInformer cache.SharedIndexInformer
 	pgLister   api_listers.PostgresLister
+
+	// PostgresDatabase
+	pgDatabaseQueue    *queue.Worker
+	pgDatabaseInformer cache.SharedIndexInformer
+	pgDatabaseLister   api_listers.PostgresDatabaseLister
+
+	// PostgresRole
+	pgRoleQueue    *queue.Worker
+	pgRoleInformer cache.SharedIndexInformer
+	pgRoleLister   api_listers.PostgresRoleLister
+
+	// PgBouncer
+	pbQueue    *queue.Worker
+	pbInformer cache.SharedIndexInformer
+	pbLister   api_listers.PgBouncerLister
 }
 
 var _ amc.Snapshotter = &Controller{}
@@ -109,7 +125,11 @@ func (c *Controller) EnsureCustomResourceDefinitions() error {
 	log.Infoln("Ensuring CustomResourceDefinition...")
 	crds := []*crd_api.CustomResourceDefinition{
 		api.Postgres{}.CustomResourceDefinition(),
+		api.PostgresDatabase{}.CustomResourceDefinition(),
+		api.PostgresRole{}.CustomResourceDefinition(),
 		catalog.PostgresVersion{}.CustomResourceDefinition(),
+		api.PgBouncer{}.CustomResourceDefinition(),
+		catalog.PgBouncerVersion{}.CustomResourceDefinition(),
 		api.DormantDatabase{}.CustomResourceDefinition(),
 		api.Snapshot{}.CustomResourceDefinition(),
 		appcat.AppBinding{}.CustomResourceDefinition(),
@@ -134,9 +154,26 @@ func (c *Controller) RunControllers(stopCh <-chan struct{}) {
 
 	// Watch x  TPR objects
 	c.pgQueue.Run(stopCh)
+	c.pgDatabaseQueue.Run(stopCh)
+	c.pgRoleQueue.Run(stopCh)
+	c.pbQueue.Run(stopCh)
 	c.DrmnQueue.Run(stopCh)
 	c.SnapQueue.Run(stopCh)
 	c.JobQueue.Run(stopCh)
+
+	go wait.Until(c.syncMemberStatuses, memberStatusPeriod, stopCh)
+	go wait.Until(c.syncUpgrades, upgradeSyncPeriod, stopCh)
+	go wait.Until(c.syncRollouts, rolloutSyncPeriod, stopCh)
+	go wait.Until(c.syncVolumeExpansions, volumeExpansionSyncPeriod, stopCh)
+	go wait.Until(c.syncCertificates, certSyncPeriod, stopCh)
+	go wait.Until(c.syncPasswordRotations, passwordRotationSyncPeriod, stopCh)
+	go wait.Until(c.syncPendingRestarts, parametersSyncPeriod, stopCh)
+	go wait.Until(c.syncPostgresDatabases, declarativeSyncPeriod, stopCh)
+	go wait.Until(c.syncPostgresRoles, declarativeSyncPeriod, stopCh)
+	go wait.Until(c.syncPgBouncers, pgbouncerSyncPeriod, stopCh)
+	go wait.Until(c.syncBaseBackups, baseBackupSyncPeriod, stopCh)
+	go wait.Until(c.pruneArchives, archivePruneSyncPeriod, stopCh)
+	go wait.Until(c.syncRecoveryWindows, recoveryWindowSyncPeriod, stopCh)
 }
 
 // Blocks caller. Intended to be called as a Go routine.
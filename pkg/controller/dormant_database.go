@@ -54,7 +54,7 @@ func (c *Controller) WaitUntilPaused(drmn *api.DormantDatabase) error {
 		return err
 	}
 
-	if err := c.deleteLeaderLockConfigMap(db.ObjectMeta); err != nil {
+	if err := c.deleteLeaderLock(db.ObjectMeta); err != nil {
 		return err
 	}
 
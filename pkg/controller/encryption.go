@@ -0,0 +1,278 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+
+	"gomodules.xyz/stow"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	EventReasonEncryptionKeyReplaced = "EncryptionKeyReplaced"
+	EventReasonEncryptionKeyMissing  = "EncryptionKeyMissing"
+
+	// directory of an archive, with the digests of the keys that the archive is encrypted with, named by key id
+	archiveEncryptionDir = "encryption"
+	keyDigestSuffix      = ".sha256"
+	// file of a snapshot, with the digest of the key that it is encrypted with. It is written by the backup job.
+	snapshotKeyDigestFile = "encryption-key.sha256"
+)
+
+// keyDigest returns the hex encoded sha256 digest, that a key is recorded with in archives and snapshots.
+func keyDigest(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:])
+}
+
+// encryptionKeys returns the keys of the encryption secret in namespace, by key id.
+func (c *Controller) encryptionKeys(namespace string, encryption *api.BackupEncryptionSpec) (map[string][]byte, error) {
+	secret, err := c.Client.CoreV1().Secrets(namespace).Get(encryption.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return secret.Data, nil
+}
+
+// missingKeys returns the key ids of digests, whose key is not among keys, sorted. Keys are matched by digest, so
+// that a key is found under another key id as well.
+func missingKeys(digests map[string]string, keys map[string][]byte) []string {
+	found := map[string]bool{}
+	for _, key := range keys {
+		found[keyDigest(key)] = true
+	}
+	var missing []string
+	for id, digest := range digests {
+		if !found[digest] {
+			missing = append(missing, id)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// readItem returns the content of the object id in container. found is false, if there is no such object.
+func readItem(container stow.Container, id string) (content []byte, found bool, err error) {
+	item, err := container.Item(id)
+	if err == stow.ErrNotFound {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	r, err := item.Open()
+	if err != nil {
+		return nil, false, err
+	}
+	defer r.Close()
+	content, err = ioutil.ReadAll(r)
+	if err != nil {
+		return nil, false, err
+	}
+	return content, true, nil
+}
+
+// archiveKeyDigests returns the digests of the keys that the archive under prefix is encrypted with, by key id.
+func archiveKeyDigests(container stow.Container, prefix string) (map[string]string, error) {
+	dir := path.Join(prefix, archiveEncryptionDir) + "/"
+	digests := map[string]string{}
+	cursor := stow.CursorStart
+	for {
+		page, next, err := container.Items(dir, cursor, 50)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page {
+			name := strings.TrimPrefix(item.ID(), dir)
+			if !strings.HasSuffix(name, keyDigestSuffix) {
+				continue
+			}
+			digest, _, err := readItem(container, item.ID())
+			if err != nil {
+				return nil, err
+			}
+			digests[strings.TrimSuffix(name, keyDigestSuffix)] = string(bytes.TrimSpace(digest))
+		}
+		cursor = next
+		if stow.IsCursorEnd(cursor) {
+			break
+		}
+	}
+	return digests, nil
+}
+
+// ensureArchiveEncryption records the digest of the key of spec.archiver.encryption in the archive of postgres,
+// before the members encrypt with it. Rotating to a new key id keeps the digests of the older keys, so that the
+// restores of the archive are checked against all of them. A key id that is recorded with another digest is
+// rejected, as the backups encrypted with the replaced key would become unreadable.
+func (c *Controller) ensureArchiveEncryption(postgres *api.Postgres) error {
+	archiver := postgres.Spec.Archiver
+	if archiver == nil || archiver.Storage == nil || archiver.Storage.Local != nil || archiver.Encryption == nil {
+		return nil
+	}
+	encryption := archiver.Encryption
+	keys, err := c.encryptionKeys(postgres.Namespace, encryption)
+	if err != nil {
+		return err
+	}
+	key, found := keys[encryption.KeyID]
+	if !found {
+		return fmt.Errorf("encryption key %q not found in secret %s/%s", encryption.KeyID, postgres.Namespace, encryption.SecretName)
+	}
+
+	container, err := c.archiveContainer(postgres)
+	if err != nil {
+		return err
+	}
+	digests, err := archiveKeyDigests(container, WalDataDir(postgres))
+	if err != nil {
+		return err
+	}
+	digest := keyDigest(key)
+	if recorded, found := digests[encryption.KeyID]; found && recorded != digest {
+		err := fmt.Errorf("encryption key %q of secret %s/%s is not the key that the archive has been encrypted with. "+
+			"A key is rotated by adding a new key to the secret and changing keyID", encryption.KeyID, postgres.Namespace, encryption.SecretName)
+		c.recorder.Event(postgres, core.EventTypeWarning, EventReasonEncryptionKeyReplaced, err.Error())
+		return err
+	}
+	if missing := missingKeys(digests, keys); len(missing) > 0 {
+		c.recorder.Eventf(
+			postgres,
+			core.EventTypeWarning,
+			EventReasonEncryptionKeyMissing,
+			"Archive has been encrypted with keys %s, that are not in secret %s/%s. The backups encrypted with them can not be restored",
+			strings.Join(missing, ", "),
+			postgres.Namespace,
+			encryption.SecretName,
+		)
+	}
+	if _, found := digests[encryption.KeyID]; found {
+		return nil
+	}
+
+	id := path.Join(WalDataDir(postgres), archiveEncryptionDir, encryption.KeyID+keyDigestSuffix)
+	if _, err := container.Put(id, strings.NewReader(digest), int64(len(digest)), nil); err != nil {
+		return fmt.Errorf("failed to record encryption key %q in the archive. Reason: %v", encryption.KeyID, err)
+	}
+	return nil
+}
+
+// checkArchiveDecryption returns the reason, why the archive under prefix can not be decrypted with the keys of
+// encryption in namespace. It is empty, if the archive has no recorded keys or all of them are in the secret.
+func (c *Controller) checkArchiveDecryption(container stow.Container, prefix, namespace string, encryption *api.BackupEncryptionSpec) (string, error) {
+	digests, err := archiveKeyDigests(container, prefix)
+	if err != nil {
+		return "", err
+	}
+	if len(digests) == 0 {
+		return "", nil
+	}
+	if encryption == nil {
+		return fmt.Sprintf("archive %s is encrypted, but no encryption secret is set to restore it", prefix), nil
+	}
+	keys, err := c.encryptionKeys(namespace, encryption)
+	if kerr.IsNotFound(err) {
+		return fmt.Sprintf("encryption secret %s/%s not found", namespace, encryption.SecretName), nil
+	} else if err != nil {
+		return "", err
+	}
+	if missing := missingKeys(digests, keys); len(missing) > 0 {
+		return fmt.Sprintf("archive %s is encrypted with keys %s, that are not in encryption secret %s/%s",
+			prefix, strings.Join(missing, ", "), namespace, encryption.SecretName), nil
+	}
+	return "", nil
+}
+
+// checkInitDecryption checks that the archive of spec.init.postgresWAL can be decrypted, before the StatefulSet is
+// created for the primary to restore it. It returns true, if postgres has failed to initialize.
+func (c *Controller) checkInitDecryption(postgres *api.Postgres) (bool, error) {
+	if postgres.Spec.Init == nil || postgres.Spec.Init.PostgresWAL == nil || postgres.Spec.Init.PostgresWAL.Local != nil {
+		return false, nil
+	}
+	if _, err := c.Client.AppsV1().StatefulSets(postgres.Namespace).Get(postgres.OffshootName(), metav1.GetOptions{}); err == nil {
+		return false, nil
+	} else if !kerr.IsNotFound(err) {
+		return false, err
+	}
+	if postgres.Status.Phase != api.DatabasePhaseCreating {
+		// the StatefulSet of a running Postgres is recreated with its data
+		return postgres.Status.Phase == api.DatabasePhaseFailed, nil
+	}
+
+	wal := postgres.Spec.Init.PostgresWAL
+	container, err := c.backendContainer(wal.Backend, postgres.Namespace)
+	if err != nil {
+		return false, err
+	}
+	prefix, err := wal.Backend.Prefix()
+	if err != nil {
+		return false, err
+	}
+	reason, err := c.checkArchiveDecryption(container, prefix, postgres.Namespace, wal.Encryption)
+	if err != nil || reason == "" {
+		return false, err
+	}
+	c.pushFailureEvent(postgres, reason)
+	return true, nil
+}
+
+// checkSnapshotDecryption returns the reason, why snapshot can not be decrypted with the keys of its spec.encryption.
+// It is empty, if the snapshot is not encrypted or it is encrypted with a key of the secret. A snapshot in a local
+// volume is not checked, as the operator does not mount it.
+func (c *Controller) checkSnapshotDecryption(snapshot *api.Snapshot) (string, error) {
+	if snapshot.Spec.Backend.Local != nil {
+		return "", nil
+	}
+	container, err := c.backendContainer(snapshot.Spec.Backend, snapshot.Namespace)
+	if err != nil {
+		return "", err
+	}
+	folder, err := snapshot.Location()
+	if err != nil {
+		return "", err
+	}
+	digest, found, err := readItem(container, path.Join(folder, snapshot.Name, snapshotKeyDigestFile))
+	if err != nil || !found {
+		return "", err
+	}
+
+	encryption := snapshot.Spec.Encryption
+	if encryption == nil {
+		return fmt.Sprintf("snapshot %s/%s is encrypted, but spec.encryption of the snapshot is not set", snapshot.Namespace, snapshot.Name), nil
+	}
+	keys, err := c.encryptionKeys(snapshot.Namespace, encryption)
+	if kerr.IsNotFound(err) {
+		return fmt.Sprintf("encryption secret %s/%s not found", snapshot.Namespace, encryption.SecretName), nil
+	} else if err != nil {
+		return "", err
+	}
+	if missing := missingKeys(map[string]string{"": string(bytes.TrimSpace(digest))}, keys); len(missing) > 0 {
+		return fmt.Sprintf("snapshot %s/%s is encrypted with a key, that is not in encryption secret %s/%s",
+			snapshot.Namespace, snapshot.Name, snapshot.Namespace, encryption.SecretName), nil
+	}
+	return "", nil
+}
@@ -0,0 +1,451 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	store "kmodules.xyz/objectstore-api/api/v1"
+)
+
+// fakeS3 is an S3 compatible object store, that serves the requests of the archive to a single bucket.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	lastMod time.Time
+}
+
+type fakeS3Object struct {
+	Key          string
+	LastModified string
+	ETag         string
+	Size         int
+	StorageClass string
+}
+
+type fakeS3ListResult struct {
+	XMLName     xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListBucketResult"`
+	Prefix      string
+	KeyCount    int
+	MaxKeys     int
+	IsTruncated bool
+	Contents    []fakeS3Object
+}
+
+func newFakeS3(objects map[string]string) *fakeS3 {
+	s := &fakeS3{objects: map[string][]byte{}, lastMod: time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)}
+	for key, content := range objects {
+		s.objects[key] = []byte(content)
+	}
+	return s
+}
+
+// objectsUnder returns the content of the objects under prefix, by the rest of their key.
+func (s *fakeS3) objectsUnder(prefix string) map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	objects := map[string]string{}
+	for key, content := range s.objects {
+		if strings.HasPrefix(key, prefix) {
+			objects[strings.TrimPrefix(key, prefix)] = string(content)
+		}
+	}
+	return objects
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// requests are path style, /<bucket>/<key>
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	if len(parts) == 1 || parts[1] == "" {
+		query := r.URL.Query()
+		if _, found := query["location"]; found {
+			fmt.Fprint(w, `<LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/"></LocationConstraint>`)
+			return
+		}
+		prefix := query.Get("prefix")
+		startAfter := query.Get("start-after")
+		maxKeys, _ := strconv.Atoi(query.Get("max-keys"))
+		var keys []string
+		for key := range s.objects {
+			if strings.HasPrefix(key, prefix) && key > startAfter {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		result := fakeS3ListResult{Prefix: prefix, MaxKeys: maxKeys}
+		if maxKeys > 0 && len(keys) > maxKeys {
+			keys = keys[:maxKeys]
+			result.IsTruncated = true
+		}
+		for _, key := range keys {
+			result.Contents = append(result.Contents, fakeS3Object{
+				Key:          key,
+				LastModified: s.lastMod.Format(time.RFC3339),
+				ETag:         `"etag"`,
+				Size:         len(s.objects[key]),
+				StorageClass: "STANDARD",
+			})
+		}
+		result.KeyCount = len(result.Contents)
+		if err := xml.NewEncoder(w).Encode(result); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	key := parts[1]
+	switch r.Method {
+	case http.MethodPut:
+		content, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		s.objects[key] = content
+		w.Header().Set("ETag", `"etag"`)
+	case http.MethodHead, http.MethodGet:
+		content, found := s.objects[key]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Last-Modified", s.lastMod.Format(http.TimeFormat))
+		w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+		if r.Method == http.MethodGet {
+			w.Write(content)
+		}
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// newS3Backend returns a backend under prefix in the bucket archive of the S3 compatible object store at endpoint,
+// with the storage secret s3-secret.
+func newS3Backend(endpoint, prefix string) *store.Backend {
+	return &store.Backend{
+		StorageSecretName: "s3-secret",
+		S3:                &store.S3Spec{Endpoint: endpoint, Bucket: "archive", Prefix: prefix},
+	}
+}
+
+var s3Secret = &core.Secret{
+	ObjectMeta: metav1.ObjectMeta{Name: "s3-secret", Namespace: "default"},
+	Data: map[string][]byte{
+		store.AWS_ACCESS_KEY_ID:     []byte("id"),
+		store.AWS_SECRET_ACCESS_KEY: []byte("secret"),
+	},
+}
+
+func newEncryptionSecret(name string, keys map[string]string) *core.Secret {
+	secret := &core.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Data:       map[string][]byte{},
+	}
+	for id, key := range keys {
+		secret.Data[id] = []byte(key)
+	}
+	return secret
+}
+
+// recordedEvents returns the reasons of the events recorded by ctrl.
+func recordedEvents(ctrl *Controller) []string {
+	events := ctrl.recorder.(*record.FakeRecorder).Events
+	var reasons []string
+	for len(events) > 0 {
+		reasons = append(reasons, strings.Fields(<-events)[1])
+	}
+	return reasons
+}
+
+func TestEncryptionKeys(t *testing.T) {
+	encryption := &api.BackupEncryptionSpec{SecretName: "foo-encryption", KeyID: "k1"}
+
+	ctrl := newTestController([]runtime.Object{newEncryptionSecret("foo-encryption", map[string]string{"k1": "key-1"})})
+	keys, err := ctrl.encryptionKeys("default", encryption)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(keys["k1"]) != "key-1" || len(keys) != 1 {
+		t.Errorf("expected keys of the secret, got %v", keys)
+	}
+
+	ctrl = newTestController(nil)
+	if _, err := ctrl.encryptionKeys("default", encryption); !kerr.IsNotFound(err) {
+		t.Errorf("expected NotFound error, got %v", err)
+	}
+}
+
+func TestEnsureArchiveEncryption(t *testing.T) {
+	const dir = "backups/kubedb/default/foo/archive/" + archiveEncryptionDir + "/"
+
+	cases := []struct {
+		name    string
+		keys    map[string]string
+		keyID   string
+		archive map[string]string
+		err     string
+		events  []string
+		// the digests recorded in the archive afterwards, by key id
+		digests map[string]string
+	}{
+		{
+			name:  "secret missing",
+			keyID: "k1",
+			err:   `secrets "foo-encryption" not found`,
+		},
+		{
+			name:  "key missing",
+			keys:  map[string]string{"k1": "key-1"},
+			keyID: "k2",
+			err:   `encryption key "k2" not found in secret default/foo-encryption`,
+		},
+		{
+			name:    "key recorded",
+			keys:    map[string]string{"k1": "key-1"},
+			keyID:   "k1",
+			digests: map[string]string{"k1": keyDigest([]byte("key-1"))},
+		},
+		{
+			name:    "key rotated",
+			keys:    map[string]string{"k1": "key-1", "k2": "key-2"},
+			keyID:   "k2",
+			archive: map[string]string{dir + "k1.sha256": keyDigest([]byte("key-1")) + "\n"},
+			digests: map[string]string{"k1": keyDigest([]byte("key-1")), "k2": keyDigest([]byte("key-2"))},
+		},
+		{
+			name:    "key replaced",
+			keys:    map[string]string{"k1": "key-3"},
+			keyID:   "k1",
+			archive: map[string]string{dir + "k1.sha256": keyDigest([]byte("key-1"))},
+			err:     `encryption key "k1" of secret default/foo-encryption is not the key that the archive has been encrypted with`,
+			events:  []string{EventReasonEncryptionKeyReplaced},
+			digests: map[string]string{"k1": keyDigest([]byte("key-1"))},
+		},
+		{
+			name:    "older key removed",
+			keys:    map[string]string{"k2": "key-2"},
+			keyID:   "k2",
+			archive: map[string]string{dir + "k1.sha256": keyDigest([]byte("key-1"))},
+			events:  []string{EventReasonEncryptionKeyMissing},
+			digests: map[string]string{"k1": keyDigest([]byte("key-1")), "k2": keyDigest([]byte("key-2"))},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s3 := newFakeS3(c.archive)
+			server := httptest.NewServer(s3)
+			defer server.Close()
+
+			postgres := &api.Postgres{
+				TypeMeta:   metav1.TypeMeta{Kind: api.ResourceKindPostgres, APIVersion: api.SchemeGroupVersion.String()},
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+				Spec: api.PostgresSpec{
+					Archiver: &api.PostgresArchiverSpec{
+						Storage:    newS3Backend(server.URL, "backups"),
+						Encryption: &api.BackupEncryptionSpec{SecretName: "foo-encryption", KeyID: c.keyID},
+					},
+				},
+			}
+			kubeObjects := []runtime.Object{s3Secret}
+			if c.keys != nil {
+				kubeObjects = append(kubeObjects, newEncryptionSecret("foo-encryption", c.keys))
+			}
+			ctrl := newTestController(kubeObjects)
+
+			err := ctrl.ensureArchiveEncryption(postgres)
+			if c.err == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			} else if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+				t.Fatalf("expected error %q, got %v", c.err, err)
+			}
+			if events := recordedEvents(ctrl); !reflect.DeepEqual(events, c.events) {
+				t.Errorf("expected events %v, got %v", c.events, events)
+			}
+
+			var digests map[string]string
+			for name, content := range s3.objectsUnder(dir) {
+				if digests == nil {
+					digests = map[string]string{}
+				}
+				digests[strings.TrimSuffix(name, keyDigestSuffix)] = strings.TrimSpace(content)
+			}
+			if !reflect.DeepEqual(digests, c.digests) {
+				t.Errorf("expected recorded digests %v, got %v", c.digests, digests)
+			}
+		})
+	}
+}
+
+func TestCheckInitDecryption(t *testing.T) {
+	// the archive of the Postgres bar, that foo is initialized from
+	const dir = "backups/kubedb/default/bar/archive/" + archiveEncryptionDir + "/"
+	encrypted := map[string]string{dir + "k1.sha256": keyDigest([]byte("key-1"))}
+	encryption := &api.BackupEncryptionSpec{SecretName: "bar-encryption"}
+
+	cases := []struct {
+		name        string
+		init        bool
+		statefulSet bool
+		phase       api.DatabasePhase
+		encryption  *api.BackupEncryptionSpec
+		keys        map[string]string
+		archive     map[string]string
+		failed      bool
+		// the reason that postgres is recorded to have failed for
+		reason string
+	}{
+		{
+			name:  "not initialized from an archive",
+			phase: api.DatabasePhaseCreating,
+		},
+		{
+			name:        "StatefulSet created",
+			init:        true,
+			statefulSet: true,
+			phase:       api.DatabasePhaseCreating,
+			archive:     encrypted,
+		},
+		{
+			name:    "StatefulSet of a running Postgres recreated",
+			init:    true,
+			phase:   api.DatabasePhaseRunning,
+			archive: encrypted,
+		},
+		{
+			name:    "failed before",
+			init:    true,
+			phase:   api.DatabasePhaseFailed,
+			archive: encrypted,
+			failed:  true,
+		},
+		{
+			name:  "archive not encrypted",
+			init:  true,
+			phase: api.DatabasePhaseCreating,
+		},
+		{
+			name:    "encryption not set",
+			init:    true,
+			phase:   api.DatabasePhaseCreating,
+			archive: encrypted,
+			failed:  true,
+			reason:  "archive backups/kubedb/default/bar/archive is encrypted, but no encryption secret is set to restore it",
+		},
+		{
+			name:       "secret missing",
+			init:       true,
+			phase:      api.DatabasePhaseCreating,
+			encryption: encryption,
+			archive:    encrypted,
+			failed:     true,
+			reason:     "encryption secret default/bar-encryption not found",
+		},
+		{
+			name:       "key missing",
+			init:       true,
+			phase:      api.DatabasePhaseCreating,
+			encryption: encryption,
+			keys:       map[string]string{"k2": "key-2"},
+			archive:    encrypted,
+			failed:     true,
+			reason: "archive backups/kubedb/default/bar/archive is encrypted with keys k1, " +
+				"that are not in encryption secret default/bar-encryption",
+		},
+		{
+			name:       "key under another key id",
+			init:       true,
+			phase:      api.DatabasePhaseCreating,
+			encryption: encryption,
+			keys:       map[string]string{"k2": "key-2", "k3": "key-1"},
+			archive:    encrypted,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			server := httptest.NewServer(newFakeS3(c.archive))
+			defer server.Close()
+
+			postgres := &api.Postgres{
+				TypeMeta:   metav1.TypeMeta{Kind: api.ResourceKindPostgres, APIVersion: api.SchemeGroupVersion.String()},
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+				Status:     api.PostgresStatus{Phase: c.phase},
+			}
+			if c.init {
+				postgres.Spec.Init = &api.InitSpec{
+					PostgresWAL: &api.PostgresWALSourceSpec{
+						Backend:    *newS3Backend(server.URL, "backups/kubedb/default/bar/archive"),
+						Encryption: c.encryption,
+					},
+				}
+			}
+			kubeObjects := []runtime.Object{s3Secret}
+			if c.keys != nil {
+				kubeObjects = append(kubeObjects, newEncryptionSecret("bar-encryption", c.keys))
+			}
+			if c.statefulSet {
+				kubeObjects = append(kubeObjects, &apps.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}})
+			}
+			ctrl := newTestController(kubeObjects, postgres.DeepCopy())
+
+			failed, err := ctrl.checkInitDecryption(postgres)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if failed != c.failed {
+				t.Errorf("expected failed %v, got %v", c.failed, failed)
+			}
+
+			pg, err := ctrl.ExtClient.KubedbV1alpha1().Postgreses("default").Get("foo", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if c.reason == "" {
+				if pg.Status.Phase != c.phase {
+					t.Errorf("expected phase %s to be kept, got %s", c.phase, pg.Status.Phase)
+				}
+				return
+			}
+			if pg.Status.Phase != api.DatabasePhaseFailed || pg.Status.Reason != c.reason {
+				t.Errorf("expected phase %s for %q, got %s for %q", api.DatabasePhaseFailed, c.reason, pg.Status.Phase, pg.Status.Reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"fmt"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	le "kubedb.dev/postgres/pkg/leader_election"
+
+	"github.com/appscode/go/types"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientsetscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/reference"
+	core_util "kmodules.xyz/client-go/core/v1"
+)
+
+func hbaConfigMapName(postgres *api.Postgres) string {
+	return postgres.OffshootName() + "-hba"
+}
+
+// ensureClientAuthentication writes spec.clientAuthentication of postgres as pg_hba.conf to the ConfigMap, that
+// is mounted in the members. The members reload their configuration, once the change reaches the mounted file.
+// It requires the database secret, whose superuser is rejected, if logins of the superuser are restricted.
+func (c *Controller) ensureClientAuthentication(postgres *api.Postgres) error {
+	meta := metav1.ObjectMeta{
+		Name:      hbaConfigMapName(postgres),
+		Namespace: postgres.Namespace,
+	}
+	auth := postgres.Spec.ClientAuthentication
+	if auth == nil {
+		err := c.Client.CoreV1().ConfigMaps(meta.Namespace).Delete(meta.Name, &metav1.DeleteOptions{})
+		if err != nil && !kerr.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	secret, err := c.Client.CoreV1().Secrets(postgres.Namespace).Get(postgres.Spec.DatabaseSecret.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	requireSSL := postgres.Spec.TLS != nil && postgres.Spec.TLS.RequireSSL
+
+	ref, err := reference.GetReference(clientsetscheme.Scheme, postgres)
+	if err != nil {
+		return err
+	}
+	_, _, err = core_util.CreateOrPatchConfigMap(c.Client, meta, func(in *core.ConfigMap) *core.ConfigMap {
+		in.Labels = postgres.OffshootLabels()
+		core_util.EnsureOwnerReference(&in.ObjectMeta, ref)
+		in.Data = map[string]string{
+			le.HBAFile: le.HBAConf(auth, passwordUser(secret), requireSSL),
+		}
+		return in
+	})
+	return err
+}
+
+// superuserRestricted returns an error, if the operator can not log in to postgres as superuser.
+func superuserRestricted(postgres *api.Postgres) error {
+	if auth := postgres.Spec.ClientAuthentication; auth != nil && auth.LocalSuperuser {
+		return fmt.Errorf("logins of the superuser of Postgres %s/%s are restricted to its members", postgres.Namespace, postgres.Name)
+	}
+	return nil
+}
+
+func upsertClientAuthentication(statefulSet *apps.StatefulSet, postgres *api.Postgres) *apps.StatefulSet {
+	podSpec := &statefulSet.Spec.Template.Spec
+	if postgres.Spec.ClientAuthentication == nil {
+		for i, container := range podSpec.Containers {
+			podSpec.Containers[i].VolumeMounts = core_util.EnsureVolumeMountDeleted(container.VolumeMounts, "hba")
+		}
+		podSpec.Volumes = core_util.EnsureVolumeDeleted(podSpec.Volumes, "hba")
+		return statefulSet
+	}
+
+	for i, container := range podSpec.Containers {
+		if container.Name == api.ResourceSingularPostgres {
+			podSpec.Containers[i].VolumeMounts = core_util.UpsertVolumeMount(container.VolumeMounts, core.VolumeMount{
+				Name:      "hba",
+				MountPath: le.HBADir,
+				ReadOnly:  true,
+			})
+			podSpec.Volumes = core_util.UpsertVolume(podSpec.Volumes, core.Volume{
+				Name: "hba",
+				VolumeSource: core.VolumeSource{
+					ConfigMap: &core.ConfigMapVolumeSource{
+						LocalObjectReference: core.LocalObjectReference{
+							Name: hbaConfigMapName(postgres),
+						},
+						// the ConfigMap is deleted, before the volume is removed from the members
+						Optional: types.BoolP(true),
+					},
+				},
+			})
+			break
+		}
+	}
+	return statefulSet
+}
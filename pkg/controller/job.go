@@ -28,6 +28,9 @@ import (
 	storage "kmodules.xyz/objectstore-api/osm"
 )
 
+// snapshotEncryptionPath is where the keys of the encryption secret of a Snapshot are mounted in its jobs
+const snapshotEncryptionPath = "/etc/encryption"
+
 func (c *Controller) createRestoreJob(postgres *api.Postgres, snapshot *api.Snapshot) (*batch.Job, error) {
 	postgresVersion, err := c.ExtClient.CatalogV1alpha1().PostgresVersions().Get(string(postgres.Spec.Version), metav1.GetOptions{})
 	if err != nil {
@@ -190,6 +193,8 @@ func (c *Controller) createRestoreJob(postgres *api.Postgres, snapshot *api.Snap
 		job.Spec.Template.Spec.Volumes = append(job.Spec.Template.Spec.Volumes, volume)
 	}
 
+	upsertSnapshotEncryption(job, snapshot, false)
+
 	if c.EnableRBAC {
 		if snapshot.Spec.PodTemplate.Spec.ServiceAccountName == "" {
 			if err := c.ensureSnapshotRBAC(postgres); err != nil {
@@ -368,6 +373,8 @@ func (c *Controller) GetSnapshotter(snapshot *api.Snapshot) (*batch.Job, error)
 		})
 	}
 
+	upsertSnapshotEncryption(job, snapshot, true)
+
 	if c.EnableRBAC {
 		if snapshot.Spec.PodTemplate.Spec.ServiceAccountName == "" {
 			job.Spec.Template.Spec.ServiceAccountName = postgres.SnapshotSAName()
@@ -381,3 +388,32 @@ func (c *Controller) GetSnapshotter(snapshot *api.Snapshot) (*batch.Job, error)
 
 	return job, nil
 }
+
+// upsertSnapshotEncryption mounts the keys of spec.encryption of snapshot in the backup or restore job. A backup is
+// encrypted with the key spec.encryption.keyID, a restore decrypts with any key of the secret.
+func upsertSnapshotEncryption(job *batch.Job, snapshot *api.Snapshot, backup bool) {
+	encryption := snapshot.Spec.Encryption
+	if encryption == nil {
+		return
+	}
+	container := &job.Spec.Template.Spec.Containers[0]
+	if backup {
+		container.Env = core_util.UpsertEnvVars(container.Env, core.EnvVar{
+			Name:  "ENCRYPTION_KEY_ID",
+			Value: encryption.KeyID,
+		})
+	}
+	container.VolumeMounts = core_util.UpsertVolumeMount(container.VolumeMounts, core.VolumeMount{
+		Name:      "encryption",
+		MountPath: snapshotEncryptionPath,
+		ReadOnly:  true,
+	})
+	job.Spec.Template.Spec.Volumes = core_util.UpsertVolume(job.Spec.Template.Spec.Volumes, core.Volume{
+		Name: "encryption",
+		VolumeSource: core.VolumeSource{
+			Secret: &core.SecretVolumeSource{
+				SecretName: encryption.SecretName,
+			},
+		},
+	})
+}
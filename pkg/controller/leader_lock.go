@@ -0,0 +1,106 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	le "kubedb.dev/postgres/pkg/leader_election"
+
+	"github.com/appscode/go/types"
+	apps "k8s.io/api/apps/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// leaderLockType returns the lock that the leader election sidecar of postgres should use.
+// New databases use a Lease right away. Databases created by an older operator hold a ConfigMap lock,
+// they are moved over in two rolling updates: first to a dual ConfigMap+Lease lock and, once every pod
+// runs with both locks, to the Lease alone. So, pods of two consecutive phases always agree on the leader.
+func (c *Controller) leaderLockType(postgres *api.Postgres) (string, error) {
+	statefulSet, err := c.Client.AppsV1().StatefulSets(postgres.Namespace).Get(postgres.OffshootName(), metav1.GetOptions{})
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			return resourcelock.LeasesResourceLock, nil
+		}
+		return "", err
+	}
+
+	switch statefulSetLockType(statefulSet) {
+	case resourcelock.LeasesResourceLock:
+		return resourcelock.LeasesResourceLock, nil
+	case le.ConfigMapsLeasesResourceLock:
+		if isStatefulSetRolledOut(statefulSet) {
+			return resourcelock.LeasesResourceLock, nil
+		}
+	}
+	return le.ConfigMapsLeasesResourceLock, nil
+}
+
+// removeLegacyLeaderLock deletes the ConfigMap lock once every pod of postgres has moved to Lease based election.
+func (c *Controller) removeLegacyLeaderLock(postgres *api.Postgres) error {
+	statefulSet, err := c.Client.AppsV1().StatefulSets(postgres.Namespace).Get(postgres.OffshootName(), metav1.GetOptions{})
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if statefulSetLockType(statefulSet) != resourcelock.LeasesResourceLock || !isStatefulSetRolledOut(statefulSet) {
+		return nil
+	}
+	return c.deleteLeaderLockConfigMap(postgres.ObjectMeta)
+}
+
+// statefulSetLockType returns the lock type that the pod template of statefulSet is configured with.
+func statefulSetLockType(statefulSet *apps.StatefulSet) string {
+	for _, container := range statefulSet.Spec.Template.Spec.Containers {
+		if container.Name != api.ResourceSingularPostgres {
+			continue
+		}
+		for _, env := range container.Env {
+			if env.Name == le.LockTypeEnv {
+				return env.Value
+			}
+		}
+	}
+	return le.DefaultResourceLock
+}
+
+// isStatefulSetRolledOut reports whether every pod of statefulSet runs the latest pod template. The StatefulSet
+// uses the OnDelete strategy, that never advances the current revision, so the updated pods are counted instead.
+func isStatefulSetRolledOut(statefulSet *apps.StatefulSet) bool {
+	replicas := types.Int32(statefulSet.Spec.Replicas)
+	return statefulSet.Status.ObservedGeneration >= statefulSet.Generation &&
+		statefulSet.Status.UpdateRevision != "" &&
+		statefulSet.Status.Replicas == replicas &&
+		statefulSet.Status.UpdatedReplicas == replicas
+}
+
+// deleteLeaderLock removes both the Lease and the legacy ConfigMap lock of a database.
+func (c *Controller) deleteLeaderLock(meta metav1.ObjectMeta) error {
+	if err := c.Client.CoordinationV1().Leases(meta.Namespace).Delete(le.GetLeaderLockName(meta.Name), nil); err != nil && !kerr.IsNotFound(err) {
+		return err
+	}
+	return c.deleteLeaderLockConfigMap(meta)
+}
+
+func (c *Controller) deleteLeaderLockConfigMap(meta metav1.ObjectMeta) error {
+	if err := c.Client.CoreV1().ConfigMaps(meta.Namespace).Delete(le.GetLeaderLockName(meta.Name), nil); !kerr.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
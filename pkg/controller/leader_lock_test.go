@@ -0,0 +1,62 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"testing"
+
+	"github.com/appscode/go/types"
+	apps "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsStatefulSetRolledOut(t *testing.T) {
+	statefulSet := func(generation, observed int64, replicas, updated int32, current, update string) *apps.StatefulSet {
+		return &apps.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Generation: generation},
+			Spec: apps.StatefulSetSpec{
+				Replicas:       types.Int32P(3),
+				UpdateStrategy: apps.StatefulSetUpdateStrategy{Type: apps.OnDeleteStatefulSetStrategyType},
+			},
+			Status: apps.StatefulSetStatus{
+				ObservedGeneration: observed,
+				Replicas:           replicas,
+				UpdatedReplicas:    updated,
+				CurrentRevision:    current,
+				UpdateRevision:     update,
+			},
+		}
+	}
+	cases := []struct {
+		name        string
+		statefulSet *apps.StatefulSet
+		want        bool
+	}{
+		{"every pod updated, current revision not advanced by OnDelete", statefulSet(2, 2, 3, 3, "rev-1", "rev-2"), true},
+		{"every pod updated", statefulSet(2, 2, 3, 3, "rev-2", "rev-2"), true},
+		{"pod outdated", statefulSet(2, 2, 3, 2, "rev-1", "rev-2"), false},
+		{"pod missing", statefulSet(2, 2, 2, 2, "rev-1", "rev-2"), false},
+		{"generation not observed", statefulSet(3, 2, 3, 3, "rev-1", "rev-2"), false},
+		{"update revision unknown", statefulSet(2, 2, 3, 3, "", ""), false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := isStatefulSetRolledOut(c.statefulSet); got != c.want {
+				t.Errorf("isStatefulSetRolledOut() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
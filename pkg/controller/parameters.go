@@ -0,0 +1,202 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	catalog "kubedb.dev/apimachinery/apis/catalog/v1alpha1"
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	"kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1/util"
+	le "kubedb.dev/postgres/pkg/leader_election"
+
+	"github.com/appscode/go/log"
+	"github.com/appscode/go/types"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	clientsetscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/reference"
+	core_util "kmodules.xyz/client-go/core/v1"
+)
+
+const (
+	EventReasonPendingRestart = "PendingRestart"
+
+	// Annotation on the pod template with the hash of the parameters, that are applied at server start only.
+	// A change results in a new revision, that the members are restarted with, see ensureRollout.
+	AnnotationRestartParameters = "postgres.kubedb.com/restart-parameters"
+
+	// period at which the parameters pending a restart are read from the primary of each Postgres
+	parametersSyncPeriod = time.Minute
+)
+
+func parametersConfigMapName(postgres *api.Postgres) string {
+	return postgres.OffshootName() + "-parameters"
+}
+
+// ensureParameters writes spec.parameters of postgres to the ConfigMap, that is mounted in the members.
+// The members reload their configuration, once the change reaches the mounted file.
+func (c *Controller) ensureParameters(postgres *api.Postgres) error {
+	meta := metav1.ObjectMeta{
+		Name:      parametersConfigMapName(postgres),
+		Namespace: postgres.Namespace,
+	}
+	if len(postgres.Spec.Parameters) == 0 {
+		err := c.Client.CoreV1().ConfigMaps(meta.Namespace).Delete(meta.Name, &metav1.DeleteOptions{})
+		if err != nil && !kerr.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	ref, err := reference.GetReference(clientsetscheme.Scheme, postgres)
+	if err != nil {
+		return err
+	}
+	_, _, err = core_util.CreateOrPatchConfigMap(c.Client, meta, func(in *core.ConfigMap) *core.ConfigMap {
+		in.Labels = postgres.OffshootLabels()
+		core_util.EnsureOwnerReference(&in.ObjectMeta, ref)
+		in.Data = map[string]string{
+			le.ParametersFile: le.ParametersConf(postgres.Spec.Parameters),
+		}
+		return in
+	})
+	return err
+}
+
+// restartParameters returns the hash of the parameters of postgres, that are applied at server start only.
+// Parameters that are missing in the catalog of postgresVersion are regarded as such, as they can not be
+// validated by the admission webhook either. It is empty, if there are none.
+func restartParameters(postgres *api.Postgres, postgresVersion *catalog.PostgresVersion) string {
+	var restart []string
+	for name, value := range postgres.Spec.Parameters {
+		if param, found := postgresVersion.Parameter(name); found && param.Context != catalog.PostgresParameterContextPostmaster {
+			continue
+		}
+		restart = append(restart, strings.ToLower(name)+"="+value)
+	}
+	if len(restart) == 0 {
+		return ""
+	}
+	sort.Strings(restart)
+	hash := sha256.Sum256([]byte(strings.Join(restart, "\n")))
+	return hex.EncodeToString(hash[:])[:16]
+}
+
+func upsertParameters(statefulSet *apps.StatefulSet, postgres *api.Postgres) *apps.StatefulSet {
+	podSpec := &statefulSet.Spec.Template.Spec
+	if len(postgres.Spec.Parameters) == 0 {
+		for i, container := range podSpec.Containers {
+			podSpec.Containers[i].VolumeMounts = core_util.EnsureVolumeMountDeleted(container.VolumeMounts, "parameters")
+		}
+		podSpec.Volumes = core_util.EnsureVolumeDeleted(podSpec.Volumes, "parameters")
+		return statefulSet
+	}
+
+	for i, container := range podSpec.Containers {
+		if container.Name == api.ResourceSingularPostgres {
+			podSpec.Containers[i].VolumeMounts = core_util.UpsertVolumeMount(container.VolumeMounts, core.VolumeMount{
+				Name:      "parameters",
+				MountPath: le.ParametersDir,
+				ReadOnly:  true,
+			})
+			podSpec.Volumes = core_util.UpsertVolume(podSpec.Volumes, core.Volume{
+				Name: "parameters",
+				VolumeSource: core.VolumeSource{
+					ConfigMap: &core.ConfigMapVolumeSource{
+						LocalObjectReference: core.LocalObjectReference{
+							Name: parametersConfigMapName(postgres),
+						},
+						// the ConfigMap is deleted, before the volume is removed from the members
+						Optional: types.BoolP(true),
+					},
+				},
+			})
+			break
+		}
+	}
+	return statefulSet
+}
+
+// syncPendingRestarts reports the parameters in the status of each running Postgres, that are changed on its
+// primary, but are waiting for the primary to be restarted.
+func (c *Controller) syncPendingRestarts() {
+	dbs, err := c.pgLister.List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	for _, postgres := range dbs {
+		if postgres.DeletionTimestamp != nil || postgres.Status.Phase != api.DatabasePhaseRunning ||
+			(len(postgres.Spec.Parameters) == 0 && len(postgres.Status.PendingRestart) == 0) ||
+			superuserRestricted(postgres) != nil {
+			continue
+		}
+		if err := c.syncPendingRestart(postgres.DeepCopy()); err != nil {
+			log.Errorf("failed to read parameters pending restart of Postgres %s/%s. Reason: %v", postgres.Namespace, postgres.Name, err)
+		}
+	}
+}
+
+func (c *Controller) syncPendingRestart(postgres *api.Postgres) error {
+	db, err := c.openPostgres(postgres, "postgres")
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	rows, err := db.Query("SELECT name FROM pg_settings WHERE pending_restart ORDER BY name")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	var pending []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return err
+		}
+		pending = append(pending, name)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if reflect.DeepEqual(pending, postgres.Status.PendingRestart) {
+		return nil
+	}
+	if len(pending) > 0 {
+		c.recorder.Eventf(postgres, core.EventTypeNormal, EventReasonPendingRestart,
+			"parameters %s take effect, once the members are restarted", strings.Join(pending, ", "))
+	}
+	_, err = util.UpdatePostgresStatus(c.ExtClient.KubedbV1alpha1(), postgres, func(in *api.PostgresStatus) *api.PostgresStatus {
+		in.PendingRestart = pending
+		return in
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update status. Reason: %v", err)
+	}
+	return nil
+}
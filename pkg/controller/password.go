@@ -0,0 +1,372 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	"kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1/util"
+	le "kubedb.dev/postgres/pkg/leader_election"
+
+	"github.com/appscode/go/crypto/rand"
+	"github.com/appscode/go/log"
+	cron "github.com/robfig/cron/v3"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	core_util "kmodules.xyz/client-go/core/v1"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+const (
+	EventReasonPasswordRotationStarted = "PasswordRotationStarted"
+	EventReasonPasswordRotated         = "PasswordRotated"
+	EventReasonPasswordRotationFailed  = "PasswordRotationFailed"
+
+	// AnnotationPasswordRotated is set on the pod template to the time of the last password rotation,
+	// so that the members and the exporter are restarted with the new password
+	AnnotationPasswordRotated = "postgres.kubedb.com/password-rotated"
+
+	// period at which the Postgres objects are checked for due and pending password rotations
+	passwordRotationSyncPeriod = time.Minute
+	// a rotation fails, if the new password is not set on the primary within this duration
+	passwordRotationTimeout = 5 * time.Minute
+)
+
+// ensurePasswordRotation rotates the password of the database superuser, when it is requested through the
+// rotate-password annotation of postgres, or when it is due by spec.passwordRotation.schedule.
+// The superuser alternates between two login roles. A new password and the other role are stored in the
+// database secret as le.PasswordNextKey and le.UserNextKey, and the rotation is recorded as Pending in status.
+// The leader election sidecar of the primary sets the password on the other role, makes the current role valid
+// until the grace period has passed, and records the rotation as Applied. Then the new credentials replace the
+// previous ones in the database secret, which are kept as le.UserPreviousKey and le.PasswordPreviousKey for the
+// grace period, and the members are restarted with the new credentials.
+func (c *Controller) ensurePasswordRotation(postgres *api.Postgres) error {
+	if postgres.Spec.DatabaseSecret == nil {
+		return nil
+	}
+
+	if rotation := postgres.Status.PasswordRotation; rotation != nil {
+		switch rotation.Phase {
+		case api.PasswordRotationPhasePending:
+			if time.Since(rotation.LastTransitionTime.Time) > passwordRotationTimeout {
+				return c.failPasswordRotation(postgres,
+					fmt.Sprintf("new password was not set on the primary within %v", passwordRotationTimeout))
+			}
+			return nil
+		case api.PasswordRotationPhaseApplied:
+			return c.completePasswordRotation(postgres)
+		case api.PasswordRotationPhaseFailed:
+			if err := c.discardNextPassword(postgres); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := c.expirePreviousPassword(postgres); err != nil {
+		return err
+	}
+
+	_, requested := postgres.Annotations[api.AnnotationRotatePassword]
+	if !requested && !passwordRotationDue(postgres, time.Now()) {
+		return nil
+	}
+	// the rotation is retried by syncPasswordRotations, once the members are not busy anymore
+	if reason, err := c.passwordRotationBlocker(postgres); err != nil {
+		return err
+	} else if reason != "" {
+		log.Infof("password rotation of Postgres %s/%s is deferred, as %s", postgres.Namespace, postgres.Name, reason)
+		return nil
+	}
+
+	if err := c.startPasswordRotation(postgres); err != nil {
+		return err
+	}
+	if requested {
+		pg, _, err := util.PatchPostgres(c.ExtClient.KubedbV1alpha1(), postgres, func(in *api.Postgres) *api.Postgres {
+			delete(in.Annotations, api.AnnotationRotatePassword)
+			return in
+		})
+		if err != nil {
+			return err
+		}
+		postgres.ObjectMeta = pg.ObjectMeta
+	}
+	return nil
+}
+
+// passwordRotationDue returns true, if a rotation is scheduled by spec.passwordRotation.schedule since the
+// last successful rotation. A failed rotation is retried at the next scheduled time.
+func passwordRotationDue(postgres *api.Postgres, now time.Time) bool {
+	if postgres.Spec.PasswordRotation == nil || postgres.Spec.PasswordRotation.Schedule == "" {
+		return false
+	}
+	schedule, err := cron.ParseStandard(postgres.Spec.PasswordRotation.Schedule)
+	if err != nil {
+		return false
+	}
+	last := postgres.CreationTimestamp.Time
+	if rotation := postgres.Status.PasswordRotation; rotation != nil {
+		if rotation.LastRotationTime != nil {
+			last = rotation.LastRotationTime.Time
+		}
+		if rotation.Phase == api.PasswordRotationPhaseFailed && rotation.LastTransitionTime.After(last) {
+			last = rotation.LastTransitionTime.Time
+		}
+	}
+	return !schedule.Next(last).After(now)
+}
+
+// passwordRotationBlocker returns why the password of postgres can not be rotated now. The members must not be
+// restarted or switched over by another operation, and backup jobs must not be running with the current password.
+func (c *Controller) passwordRotationBlocker(postgres *api.Postgres) (string, error) {
+	if postgres.Status.Phase != api.DatabasePhaseRunning {
+		return fmt.Sprintf("Postgres is %s", postgres.Status.Phase), nil
+	}
+	if upgrade := postgres.Status.Upgrade; upgrade != nil && upgradeInProgress(upgrade.Phase) {
+		return "an upgrade is in progress", nil
+	}
+	if rollout := postgres.Status.Rollout; rollout != nil && rollout.Phase == api.RolloutPhaseRunning {
+		return "members are being restarted", nil
+	}
+	if sw := postgres.Status.Switchover; sw != nil && (sw.Phase == api.SwitchoverPhasePending || sw.Phase == api.SwitchoverPhaseRunning) {
+		return "a switchover is in progress", nil
+	}
+	// the next rotation sets the password of the login role, that the previous credentials belong to
+	if rotation := postgres.Status.PasswordRotation; rotation != nil && rotation.PreviousPasswordExpiry != nil {
+		return fmt.Sprintf("the previous credentials are valid until %v", rotation.PreviousPasswordExpiry.UTC().Format(time.RFC3339)), nil
+	}
+	if expansion := postgres.Status.VolumeExpansion; expansion != nil &&
+		(expansion.Phase == api.VolumeExpansionPhaseExpanding || expansion.Phase == api.VolumeExpansionPhaseRecreating) {
+		return "volumes are being expanded", nil
+	}
+
+	snapshots, err := c.ExtClient.KubedbV1alpha1().Snapshots(postgres.Namespace).List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(map[string]string{
+			api.LabelDatabaseKind:   api.ResourceKindPostgres,
+			api.LabelDatabaseName:   postgres.Name,
+			api.LabelSnapshotStatus: string(api.SnapshotPhaseRunning),
+		}).String(),
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(snapshots.Items) > 0 {
+		return fmt.Sprintf("snapshot %s is running", snapshots.Items[0].Name), nil
+	}
+	return "", nil
+}
+
+// startPasswordRotation stores a new password and the alternate login role of the superuser in the database
+// secret, for the primary to set it.
+func (c *Controller) startPasswordRotation(postgres *api.Postgres) error {
+	secret, err := c.Client.CoreV1().Secrets(postgres.Namespace).Get(postgres.Spec.DatabaseSecret.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if _, _, err := core_util.PatchSecret(c.Client, secret, func(in *core.Secret) *core.Secret {
+		if in.Data == nil {
+			in.Data = map[string][]byte{}
+		}
+		in.Data[le.UserNextKey] = []byte(le.AlternateUser(passwordUser(secret)))
+		in.Data[le.PasswordNextKey] = []byte(rand.GeneratePassword())
+		return in
+	}); err != nil {
+		return err
+	}
+
+	msg := fmt.Sprintf("rotating password of %s to %s", passwordUser(secret), le.AlternateUser(passwordUser(secret)))
+	c.recorder.Event(postgres, core.EventTypeNormal, EventReasonPasswordRotationStarted, msg)
+	return c.updatePasswordRotation(postgres, api.PasswordRotationPhasePending, msg, false)
+}
+
+// completePasswordRotation replaces the credentials in the database secret with the new credentials, that the
+// primary has set. The previous credentials are kept for the grace period, while they are valid.
+func (c *Controller) completePasswordRotation(postgres *api.Postgres) error {
+	secret, err := c.Client.CoreV1().Secrets(postgres.Namespace).Get(postgres.Spec.DatabaseSecret.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	// the secret is already updated, if only recording the completion has failed before
+	previous := passwordUser(secret)
+	if next := secret.Data[le.PasswordNextKey]; len(next) > 0 {
+		nextUser := secret.Data[le.UserNextKey]
+		if len(nextUser) == 0 {
+			nextUser = []byte(le.AlternateUser(previous))
+		}
+		if _, _, err := core_util.PatchSecret(c.Client, secret, func(in *core.Secret) *core.Secret {
+			in.Data[le.UserPreviousKey] = []byte(previous)
+			in.Data[le.PasswordPreviousKey] = in.Data[PostgresPassword]
+			in.Data[PostgresUser] = nextUser
+			in.Data[PostgresPassword] = next
+			delete(in.Data, le.UserNextKey)
+			delete(in.Data, le.PasswordNextKey)
+			return in
+		}); err != nil {
+			return err
+		}
+	} else {
+		previous = string(secret.Data[le.UserPreviousKey])
+	}
+
+	msg := fmt.Sprintf("password of %s is rotated, %s is valid until %v", passwordUser(secret), previous, previousExpiry(postgres))
+	c.recorder.Event(postgres, core.EventTypeNormal, EventReasonPasswordRotated, msg)
+	return c.updatePasswordRotation(postgres, api.PasswordRotationPhaseSucceeded, msg, true)
+}
+
+// failPasswordRotation gives up a rotation, that the primary has not recorded as Applied. The current credentials
+// stay in use. The status is only updated, if it has not changed since postgres was read, as the primary records
+// Applied the same way. If the primary has committed the new password meanwhile, it makes the current role valid
+// again, once it finds the rotation Failed. A conflict is returned as error, and the rotation is processed again.
+func (c *Controller) failPasswordRotation(postgres *api.Postgres, reason string) error {
+	in := postgres.DeepCopy()
+	in.Status.PasswordRotation.Phase = api.PasswordRotationPhaseFailed
+	in.Status.PasswordRotation.Reason = reason
+	in.Status.PasswordRotation.LastTransitionTime = metav1.Now()
+	pg, err := c.ExtClient.KubedbV1alpha1().Postgreses(postgres.Namespace).UpdateStatus(in)
+	if err != nil {
+		return err
+	}
+	postgres.Status = pg.Status
+	c.recorder.Event(postgres, core.EventTypeWarning, EventReasonPasswordRotationFailed, reason)
+	return c.discardNextPassword(postgres)
+}
+
+// discardNextPassword removes the new credentials of a failed rotation from the database secret.
+func (c *Controller) discardNextPassword(postgres *api.Postgres) error {
+	secret, err := c.Client.CoreV1().Secrets(postgres.Namespace).Get(postgres.Spec.DatabaseSecret.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	_, user := secret.Data[le.UserNextKey]
+	if _, found := secret.Data[le.PasswordNextKey]; !found && !user {
+		return nil
+	}
+	_, _, err = core_util.PatchSecret(c.Client, secret, func(in *core.Secret) *core.Secret {
+		delete(in.Data, le.UserNextKey)
+		delete(in.Data, le.PasswordNextKey)
+		return in
+	})
+	return err
+}
+
+// expirePreviousPassword removes the previous credentials from the database secret, once the grace period has
+// passed. The primary has set them to expire in the database at the same time.
+func (c *Controller) expirePreviousPassword(postgres *api.Postgres) error {
+	rotation := postgres.Status.PasswordRotation
+	if rotation == nil || rotation.PreviousPasswordExpiry == nil || rotation.PreviousPasswordExpiry.After(time.Now()) {
+		return nil
+	}
+	secret, err := c.Client.CoreV1().Secrets(postgres.Namespace).Get(postgres.Spec.DatabaseSecret.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	_, user := secret.Data[le.UserPreviousKey]
+	if _, found := secret.Data[le.PasswordPreviousKey]; found || user {
+		if _, _, err := core_util.PatchSecret(c.Client, secret, func(in *core.Secret) *core.Secret {
+			delete(in.Data, le.UserPreviousKey)
+			delete(in.Data, le.PasswordPreviousKey)
+			return in
+		}); err != nil {
+			return err
+		}
+	}
+
+	pg, err := util.UpdatePostgresStatus(c.ExtClient.KubedbV1alpha1(), postgres, func(in *api.PostgresStatus) *api.PostgresStatus {
+		if in.PasswordRotation != nil {
+			in.PasswordRotation.PreviousPasswordExpiry = nil
+		}
+		return in
+	})
+	if err != nil {
+		return err
+	}
+	postgres.Status = pg.Status
+	return nil
+}
+
+// updatePasswordRotation records the progress of the rotation in the status of postgres. The rotation time is
+// recorded, once the rotation has succeeded. The expiry of the previous credentials is recorded by the primary.
+func (c *Controller) updatePasswordRotation(postgres *api.Postgres, phase api.PasswordRotationPhase, reason string, rotated bool) error {
+	pg, err := util.UpdatePostgresStatus(c.ExtClient.KubedbV1alpha1(), postgres, func(in *api.PostgresStatus) *api.PostgresStatus {
+		if in.PasswordRotation == nil {
+			in.PasswordRotation = &api.PostgresPasswordRotationStatus{}
+		}
+		now := metav1.Now()
+		in.PasswordRotation.Phase = phase
+		in.PasswordRotation.Reason = reason
+		in.PasswordRotation.LastTransitionTime = now
+		if rotated {
+			in.PasswordRotation.LastRotationTime = &now
+		}
+		return in
+	})
+	if err != nil {
+		return err
+	}
+	postgres.Status = pg.Status
+	return nil
+}
+
+// passwordRotated returns the time of the last rotation of the password of postgres, to restart the members with.
+func passwordRotated(postgres *api.Postgres) string {
+	if rotation := postgres.Status.PasswordRotation; rotation != nil && rotation.LastRotationTime != nil {
+		return rotation.LastRotationTime.UTC().Format(time.RFC3339)
+	}
+	return ""
+}
+
+// previousExpiry returns the time that the previous credentials of postgres expire at.
+func previousExpiry(postgres *api.Postgres) string {
+	if rotation := postgres.Status.PasswordRotation; rotation != nil && rotation.PreviousPasswordExpiry != nil {
+		return rotation.PreviousPasswordExpiry.UTC().Format(time.RFC3339)
+	}
+	return "now"
+}
+
+func passwordUser(secret *core.Secret) string {
+	if user := string(secret.Data[PostgresUser]); user != "" {
+		return user
+	}
+	return "postgres"
+}
+
+// syncPasswordRotations processes the Postgres objects again, whose password rotation is in progress, due by
+// schedule or waiting for the members, or whose previous password has expired.
+func (c *Controller) syncPasswordRotations() {
+	dbs, err := c.pgLister.List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	now := time.Now()
+	for _, postgres := range dbs {
+		if postgres.DeletionTimestamp != nil || postgres.Spec.DatabaseSecret == nil {
+			continue
+		}
+		if rotation := postgres.Status.PasswordRotation; rotation != nil &&
+			(rotation.Phase == api.PasswordRotationPhasePending || rotation.Phase == api.PasswordRotationPhaseApplied ||
+				(rotation.PreviousPasswordExpiry != nil && !rotation.PreviousPasswordExpiry.After(now))) {
+			queue.Enqueue(c.pgQueue.GetQueue(), postgres)
+			continue
+		}
+		if _, requested := postgres.Annotations[api.AnnotationRotatePassword]; requested || passwordRotationDue(postgres, now) {
+			queue.Enqueue(c.pgQueue.GetQueue(), postgres)
+		}
+	}
+}
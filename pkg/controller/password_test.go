@@ -0,0 +1,102 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"testing"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	"kubedb.dev/apimachinery/client/clientset/versioned/fake"
+	le "kubedb.dev/postgres/pkg/leader_election"
+
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgotesting "k8s.io/client-go/testing"
+)
+
+// TestFailPasswordRotationConflict checks that the new password is kept, if the primary may have recorded it as
+// Applied meanwhile. Discarding it on success patches the secret, which is not served by the fake clients.
+func TestFailPasswordRotationConflict(t *testing.T) {
+	postgres := &api.Postgres{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+		Spec:       api.PostgresSpec{DatabaseSecret: &core.SecretVolumeSource{SecretName: "foo-auth"}},
+		Status: api.PostgresStatus{
+			PasswordRotation: &api.PostgresPasswordRotationStatus{
+				Phase:              api.PasswordRotationPhasePending,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+			},
+		},
+	}
+	secret := &core.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo-auth", Namespace: "default"},
+		Data: map[string][]byte{
+			PostgresPassword:   []byte("current"),
+			le.PasswordNextKey: []byte("next"),
+		},
+	}
+	ctrl := newTestController([]runtime.Object{secret}, postgres)
+	ctrl.ExtClient.(*fake.Clientset).PrependReactor("update", "postgreses", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, nil, kerr.NewConflict(schema.GroupResource{Resource: "postgreses"}, postgres.Name, nil)
+	})
+
+	if err := ctrl.failPasswordRotation(postgres, "timed out"); !kerr.IsConflict(err) {
+		t.Fatalf("expected conflict, got %v", err)
+	}
+	if postgres.Status.PasswordRotation.Phase != api.PasswordRotationPhasePending {
+		t.Errorf("expected password rotation phase %s, got %s", api.PasswordRotationPhasePending, postgres.Status.PasswordRotation.Phase)
+	}
+	secret, err := ctrl.Client.CoreV1().Secrets("default").Get("foo-auth", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(secret.Data[le.PasswordNextKey]) != "next" {
+		t.Errorf("expected next password to be kept, got %q", secret.Data[le.PasswordNextKey])
+	}
+}
+
+// TestPasswordRotationBlocker checks that a rotation waits for the previous credentials to expire, as it sets the
+// password of the login role that they belong to.
+func TestPasswordRotationBlocker(t *testing.T) {
+	expiry := metav1.NewTime(time.Now().Add(time.Hour))
+	cases := []struct {
+		name     string
+		rotation *api.PostgresPasswordRotationStatus
+		blocked  bool
+	}{
+		{"never rotated", nil, false},
+		{"previous credentials removed", &api.PostgresPasswordRotationStatus{Phase: api.PasswordRotationPhaseSucceeded}, false},
+		{"previous credentials valid", &api.PostgresPasswordRotationStatus{Phase: api.PasswordRotationPhaseSucceeded, PreviousPasswordExpiry: &expiry}, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			postgres := &api.Postgres{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+				Status:     api.PostgresStatus{Phase: api.DatabasePhaseRunning, PasswordRotation: c.rotation},
+			}
+			reason, err := newTestController(nil, postgres).passwordRotationBlocker(postgres)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if blocked := reason != ""; blocked != c.blocked {
+				t.Errorf("expected blocked %v, got reason %q", c.blocked, reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	"kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1/util"
+	"kubedb.dev/apimachinery/pkg/eventer"
+
+	"github.com/appscode/go/encoding/json/types"
+	"github.com/appscode/go/log"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	meta_util "kmodules.xyz/client-go/meta"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+// period at which PgBouncer objects are reconciled again, so that changes of the userlist secret and the
+// AppBindings of their databases are picked up
+const pgbouncerSyncPeriod = time.Minute
+
+// ensurePgBouncer runs the Deployment and the Services of pgbouncer, with the configuration rendered from
+// its spec.
+func (c *Controller) ensurePgBouncer(pgbouncer *api.PgBouncer) error {
+	pgbouncer.SetDefaults()
+	if err := validatePgBouncer(pgbouncer); err != nil {
+		c.recorder.Event(pgbouncer, core.EventTypeWarning, eventer.EventReasonInvalid, err.Error())
+		log.Errorln(err)
+		// user error so just record error and don't retry.
+		return c.updatePgBouncerStatus(pgbouncer, api.DatabasePhaseFailed, err.Error())
+	}
+
+	if pgbouncer.Status.Phase == "" {
+		if err := c.updatePgBouncerStatus(pgbouncer, api.DatabasePhaseCreating, ""); err != nil {
+			return err
+		}
+	}
+
+	version, err := c.ExtClient.CatalogV1alpha1().PgBouncerVersions().Get(string(pgbouncer.Spec.Version), metav1.GetOptions{})
+	if err != nil {
+		return c.pgbouncerFailed(pgbouncer, fmt.Errorf("failed to get PgBouncerVersion %v. Reason: %v", pgbouncer.Spec.Version, err))
+	}
+	if err := c.ensurePgBouncerConfig(pgbouncer); err != nil {
+		return c.pgbouncerFailed(pgbouncer, fmt.Errorf("failed to ensure configuration. Reason: %v", err))
+	}
+	if err := c.ensurePgBouncerService(pgbouncer); err != nil {
+		return c.pgbouncerFailed(pgbouncer, fmt.Errorf("failed to ensure Service. Reason: %v", err))
+	}
+	deployment, err := c.ensurePgBouncerDeployment(pgbouncer, version)
+	if err != nil {
+		return c.pgbouncerFailed(pgbouncer, fmt.Errorf("failed to ensure Deployment. Reason: %v", err))
+	}
+	if err := c.managePgBouncerMonitor(pgbouncer); err != nil {
+		c.recorder.Eventf(pgbouncer, core.EventTypeWarning, eventer.EventReasonFailedToCreate,
+			"Failed to manage monitoring system. Reason: %v", err)
+		log.Errorln(err)
+	}
+
+	phase := api.DatabasePhaseCreating
+	if deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.AvailableReplicas >= *pgbouncer.Spec.Replicas {
+		phase = api.DatabasePhaseRunning
+	}
+	return c.updatePgBouncerStatus(pgbouncer, phase, "")
+}
+
+// pgbouncerFailed records err on pgbouncer and returns it, so that pgbouncer is processed again.
+func (c *Controller) pgbouncerFailed(pgbouncer *api.PgBouncer, err error) error {
+	c.recorder.Eventf(pgbouncer, core.EventTypeWarning, eventer.EventReasonFailedToStart,
+		`Fail to be ready PgBouncer: "%v". Reason: %v`, pgbouncer.Name, err)
+	if uerr := c.updatePgBouncerStatus(pgbouncer, api.DatabasePhaseFailed, err.Error()); uerr != nil {
+		log.Errorln(uerr)
+	}
+	return err
+}
+
+func (c *Controller) updatePgBouncerStatus(pgbouncer *api.PgBouncer, phase api.DatabasePhase, reason string) error {
+	pb, err := util.UpdatePgBouncerStatus(c.ExtClient.KubedbV1alpha1(), pgbouncer, func(in *api.PgBouncerStatus) *api.PgBouncerStatus {
+		in.Phase = phase
+		in.Reason = reason
+		in.ObservedGeneration = types.NewIntHash(pgbouncer.Generation, meta_util.GenerationHash(pgbouncer))
+		return in
+	})
+	if err != nil {
+		return err
+	}
+	pgbouncer.Status = pb.Status
+	return nil
+}
+
+// terminatePgBouncer removes the monitoring of pgbouncer. Its other resources are garbage collected by
+// their owner reference.
+func (c *Controller) terminatePgBouncer(pgbouncer *api.PgBouncer) error {
+	if pgbouncer.Spec.Monitor == nil {
+		return nil
+	}
+	if _, err := c.deletePgBouncerMonitor(pgbouncer); err != nil {
+		log.Errorf("failed to delete monitor of PgBouncer %s/%s. Reason: %v", pgbouncer.Namespace, pgbouncer.Name, err)
+	}
+	return nil
+}
+
+// syncPgBouncers processes all PgBouncer objects again.
+func (c *Controller) syncPgBouncers() {
+	objs, err := c.pbLister.List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	for _, pgbouncer := range objs {
+		if pgbouncer.DeletionTimestamp == nil {
+			queue.Enqueue(c.pbQueue.GetQueue(), pgbouncer)
+		}
+	}
+}
@@ -0,0 +1,418 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+
+	"github.com/appscode/go/crypto/rand"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientsetscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/reference"
+	core_util "kmodules.xyz/client-go/core/v1"
+	appcat "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
+)
+
+const (
+	// Directory that pgbouncer.ini and the userlist are mounted at
+	PgBouncerConfigDir    = "/etc/pgbouncer/config"
+	PgBouncerConfigFile   = "pgbouncer.ini"
+	PgBouncerUserListFile = "userlist.txt"
+	// CA certificates that the servers of the databases are verified with, if they are reached with TLS
+	PgBouncerServerCAFile = "server-ca.crt"
+
+	// User that the exporter reads the statistics of pgbouncer as. Its password is generated in the auth secret.
+	PgBouncerStatsUser = "kubedb"
+)
+
+var (
+	pgbouncerPoolModes = sets.NewString(api.PgBouncerPoolModeSession, api.PgBouncerPoolModeTransaction, api.PgBouncerPoolModeStatement)
+	pgbouncerAuthTypes = sets.NewString("any", "cert", "hba", "md5", "pam", "plain", "scram-sha-256", "trust")
+)
+
+func pgbouncerConfigSecretName(pgbouncer *api.PgBouncer) string {
+	return pgbouncer.OffshootName() + "-config"
+}
+
+func pgbouncerAuthSecretName(pgbouncer *api.PgBouncer) string {
+	return pgbouncer.OffshootName() + "-auth"
+}
+
+// pgbouncerUpstream is the primary Service of a database, that pgbouncer connects to
+type pgbouncerUpstream struct {
+	host string
+	port int32
+	// sslmode that clients connect to the database with, and the CA that its server certificate is signed by
+	sslMode  string
+	caBundle []byte
+}
+
+// validatePgBouncer checks the spec of pgbouncer, which must have been defaulted.
+func validatePgBouncer(pgbouncer *api.PgBouncer) error {
+	pool := pgbouncer.Spec.ConnectionPool
+	if !pgbouncerPoolModes.Has(pool.PoolMode) {
+		return fmt.Errorf(`spec.connectionPool.poolMode "%s" invalid. Value must be one of %s`, pool.PoolMode, strings.Join(pgbouncerPoolModes.List(), ", "))
+	}
+	if !pgbouncerAuthTypes.Has(pool.AuthType) {
+		return fmt.Errorf(`spec.connectionPool.authType "%s" invalid. Value must be one of %s`, pool.AuthType, strings.Join(pgbouncerAuthTypes.List(), ", "))
+	}
+	if *pool.Port <= 0 || *pool.Port > 65535 {
+		return fmt.Errorf(`spec.connectionPool.port "%d" invalid`, *pool.Port)
+	}
+	for name, size := range map[string]*int{
+		"maxClientConnections":      pool.MaxClientConnections,
+		"defaultPoolSize":           pool.DefaultPoolSize,
+		"minPoolSize":               pool.MinPoolSize,
+		"reservePoolSize":           pool.ReservePoolSize,
+		"reservePoolTimeoutSeconds": pool.ReservePoolTimeoutSeconds,
+		"maxDBConnections":          pool.MaxDBConnections,
+		"maxUserConnections":        pool.MaxUserConnections,
+		"statsPeriodSeconds":        pool.StatsPeriodSeconds,
+	} {
+		if size != nil && *size < 0 {
+			return fmt.Errorf(`spec.connectionPool.%s "%d" invalid. Value must be non-negative`, name, *size)
+		}
+	}
+	for _, user := range pool.AdminUsers {
+		if user == "" || strings.ContainsAny(user, ", \t\r\n") {
+			return fmt.Errorf(`spec.connectionPool.adminUsers "%s" invalid`, user)
+		}
+	}
+	if strings.ContainsAny(pool.AuthUser, " \t\r\n") {
+		return fmt.Errorf(`spec.connectionPool.authUser "%s" invalid. Value must not contain whitespace`, pool.AuthUser)
+	}
+	if strings.ContainsAny(pool.IgnoreStartupParameters, " \t\r\n") {
+		return fmt.Errorf(`spec.connectionPool.ignoreStartupParameters "%s" invalid. Value must not contain whitespace`, pool.IgnoreStartupParameters)
+	}
+
+	aliases := map[string]bool{}
+	for _, db := range pgbouncer.Spec.Databases {
+		// the admin console of pgbouncer is reached as database pgbouncer
+		if db.Alias == "" || db.Alias == "pgbouncer" || strings.ContainsAny(db.Alias, "= \t\r\n") {
+			return fmt.Errorf(`spec.databases alias "%s" invalid`, db.Alias)
+		}
+		if aliases[db.Alias] {
+			return fmt.Errorf(`spec.databases alias "%s" invalid. Alias is used more than once`, db.Alias)
+		}
+		aliases[db.Alias] = true
+		if db.DatabaseRef.Name == "" || db.DatabaseName == "" {
+			return fmt.Errorf(`spec.databases "%s" invalid. DatabaseRef and DatabaseName must be set`, db.Alias)
+		}
+		if db.Password != "" && db.UserName == "" {
+			return fmt.Errorf(`spec.databases "%s" invalid. Password requires UserName`, db.Alias)
+		}
+	}
+	return nil
+}
+
+// resolveUpstreams returns the primary Service of the database of each alias of pgbouncer, read from the
+// AppBinding that the DatabaseRef refers to.
+func (c *Controller) resolveUpstreams(pgbouncer *api.PgBouncer) (map[string]pgbouncerUpstream, error) {
+	upstreams := map[string]pgbouncerUpstream{}
+	for _, db := range pgbouncer.Spec.Databases {
+		namespace := db.DatabaseRef.Namespace
+		if namespace == "" {
+			namespace = pgbouncer.Namespace
+		}
+		binding, err := c.AppCatalogClient.AppcatalogV1alpha1().AppBindings(namespace).Get(db.DatabaseRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get AppBinding %s/%s of database %s. Reason: %v", namespace, db.DatabaseRef.Name, db.Alias, err)
+		}
+		upstream, err := appBindingUpstream(binding)
+		if err != nil {
+			return nil, fmt.Errorf("AppBinding %s/%s of database %s invalid. Reason: %v", namespace, db.DatabaseRef.Name, db.Alias, err)
+		}
+		upstreams[db.Alias] = upstream
+	}
+	return upstreams, nil
+}
+
+func appBindingUpstream(binding *appcat.AppBinding) (pgbouncerUpstream, error) {
+	service := binding.Spec.ClientConfig.Service
+	if service == nil {
+		return pgbouncerUpstream{}, fmt.Errorf("clientConfig.service is not set")
+	}
+	port := service.Port
+	if port == 0 {
+		port = PostgresPort
+	}
+	query, err := url.ParseQuery(service.Query)
+	if err != nil {
+		return pgbouncerUpstream{}, fmt.Errorf("clientConfig.service.query invalid. Reason: %v", err)
+	}
+	return pgbouncerUpstream{
+		host:     fmt.Sprintf("%s.%s.svc", service.Name, binding.Namespace),
+		port:     port,
+		sslMode:  query.Get("sslmode"),
+		caBundle: binding.Spec.ClientConfig.CABundle,
+	}, nil
+}
+
+// pgbouncerServerTLS returns the server_tls_sslmode that pgbouncer connects to upstreams with, and the CA
+// certificates to verify them with, if any. TLS applies to all upstreams. Their servers are verified, if all
+// of them require TLS and have a CA. TLS is only preferred, if some of them do not use TLS.
+func pgbouncerServerTLS(pgbouncer *api.PgBouncer, upstreams map[string]pgbouncerUpstream) (string, []byte) {
+	var tls int
+	var cas [][]byte
+	verify := true
+	for _, db := range pgbouncer.Spec.Databases {
+		upstream, found := upstreams[db.Alias]
+		if !found || upstream.sslMode == "" || upstream.sslMode == "disable" {
+			continue
+		}
+		tls++
+		if len(upstream.caBundle) == 0 {
+			verify = false
+			continue
+		}
+		known := false
+		for _, ca := range cas {
+			known = known || bytes.Equal(ca, upstream.caBundle)
+		}
+		if !known {
+			cas = append(cas, upstream.caBundle)
+		}
+	}
+
+	switch {
+	case tls == 0:
+		return "", nil
+	case tls < len(pgbouncer.Spec.Databases):
+		return "prefer", nil
+	case !verify:
+		return "require", nil
+	}
+	var bundle []byte
+	for _, ca := range cas {
+		bundle = append(bundle, bytes.TrimSpace(ca)...)
+		bundle = append(bundle, '\n')
+	}
+	return "verify-full", bundle
+}
+
+// PgBouncerConf returns pgbouncer.ini for pgbouncer, which must have been defaulted, with the databases
+// connecting to upstreams.
+func PgBouncerConf(pgbouncer *api.PgBouncer, upstreams map[string]pgbouncerUpstream) string {
+	var buf bytes.Buffer
+	buf.WriteString("# generated by the operator from the PgBouncer spec\n")
+	buf.WriteString("[databases]\n")
+	for _, db := range pgbouncer.Spec.Databases {
+		upstream := upstreams[db.Alias]
+		fields := []string{
+			"host=" + upstream.host,
+			"port=" + strconv.Itoa(int(upstream.port)),
+			"dbname=" + quoteConnValue(db.DatabaseName),
+		}
+		if db.UserName != "" {
+			fields = append(fields, "user="+quoteConnValue(db.UserName))
+		}
+		if db.Password != "" {
+			fields = append(fields, "password="+quoteConnValue(db.Password))
+		}
+		fmt.Fprintf(&buf, "%s = %s\n", db.Alias, strings.Join(fields, " "))
+	}
+
+	pool := pgbouncer.Spec.ConnectionPool
+	buf.WriteString("\n[pgbouncer]\n")
+	setting := func(name string, value interface{}) {
+		fmt.Fprintf(&buf, "%s = %v\n", name, value)
+	}
+	optional := func(name string, value *int) {
+		if value != nil {
+			setting(name, *value)
+		}
+	}
+	setting("listen_addr", "*")
+	setting("listen_port", *pool.Port)
+	setting("auth_type", pool.AuthType)
+	setting("auth_file", PgBouncerConfigDir+"/"+PgBouncerUserListFile)
+	setting("pool_mode", pool.PoolMode)
+	optional("max_client_conn", pool.MaxClientConnections)
+	optional("default_pool_size", pool.DefaultPoolSize)
+	optional("min_pool_size", pool.MinPoolSize)
+	optional("reserve_pool_size", pool.ReservePoolSize)
+	optional("reserve_pool_timeout", pool.ReservePoolTimeoutSeconds)
+	optional("max_db_connections", pool.MaxDBConnections)
+	optional("max_user_connections", pool.MaxUserConnections)
+	optional("stats_period", pool.StatsPeriodSeconds)
+	if len(pool.AdminUsers) > 0 {
+		setting("admin_users", strings.Join(pool.AdminUsers, ","))
+	}
+	setting("stats_users", PgBouncerStatsUser)
+	if pool.AuthUser != "" {
+		setting("auth_user", pool.AuthUser)
+	}
+	if pool.IgnoreStartupParameters != "" {
+		setting("ignore_startup_parameters", pool.IgnoreStartupParameters)
+	}
+	if mode, ca := pgbouncerServerTLS(pgbouncer, upstreams); mode != "" {
+		setting("server_tls_sslmode", mode)
+		if ca != nil {
+			setting("server_tls_ca_file", PgBouncerConfigDir+"/"+PgBouncerServerCAFile)
+		}
+	}
+	return buf.String()
+}
+
+// PgBouncerUserList returns the auth_file of pgbouncer with the passwords of users.
+func PgBouncerUserList(users map[string]string) string {
+	names := make([]string, 0, len(users))
+	for name := range users {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	quote := func(s string) string {
+		return `"` + strings.Replace(s, `"`, `""`, -1) + `"`
+	}
+	var buf bytes.Buffer
+	for _, name := range names {
+		fmt.Fprintf(&buf, "%s %s\n", quote(name), quote(users[name]))
+	}
+	return buf.String()
+}
+
+// quoteConnValue quotes s to be used as value in a libpq connection string.
+func quoteConnValue(s string) string {
+	if s != "" && !strings.ContainsAny(s, ` '\`) {
+		return s
+	}
+	s = strings.Replace(s, `\`, `\\`, -1)
+	return `'` + strings.Replace(s, `'`, `\'`, -1) + `'`
+}
+
+// pgbouncerUsers returns the passwords of the users of pgbouncer, from the UserListSecretRef, the databases
+// and the auth secret of the stats user.
+func (c *Controller) pgbouncerUsers(pgbouncer *api.PgBouncer, statsPassword string) (map[string]string, error) {
+	users := map[string]string{}
+	add := func(name, password, source string) error {
+		if other, found := users[name]; found && other != password {
+			return fmt.Errorf("user %s of %s has a different password already", name, source)
+		}
+		users[name] = password
+		return nil
+	}
+
+	if ref := pgbouncer.Spec.UserListSecretRef; ref != nil {
+		secret, err := c.Client.CoreV1().Secrets(pgbouncer.Namespace).Get(ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get userlist secret %s. Reason: %v", ref.Name, err)
+		}
+		for name, password := range secret.Data {
+			if err := add(name, string(password), "userlist secret "+ref.Name); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, db := range pgbouncer.Spec.Databases {
+		if db.Password == "" {
+			continue
+		}
+		if err := add(db.UserName, db.Password, "database "+db.Alias); err != nil {
+			return nil, err
+		}
+	}
+	if _, found := users[PgBouncerStatsUser]; found {
+		return nil, fmt.Errorf("user %s is reserved for the exporter", PgBouncerStatsUser)
+	}
+	users[PgBouncerStatsUser] = statsPassword
+	return users, nil
+}
+
+// ensurePgBouncerAuthSecret returns the password of the stats user, generated once in the auth secret.
+func (c *Controller) ensurePgBouncerAuthSecret(pgbouncer *api.PgBouncer) (string, error) {
+	name := pgbouncerAuthSecretName(pgbouncer)
+	secret, err := c.Client.CoreV1().Secrets(pgbouncer.Namespace).Get(name, metav1.GetOptions{})
+	if err == nil {
+		if secret.Labels[api.LabelDatabaseKind] != api.ResourceKindPgBouncer || secret.Labels[api.LabelDatabaseName] != pgbouncer.Name {
+			return "", fmt.Errorf(`intended secret "%v/%v" already exists`, pgbouncer.Namespace, name)
+		}
+		return string(secret.Data[appcat.KeyPassword]), nil
+	} else if !kerr.IsNotFound(err) {
+		return "", err
+	}
+
+	ref, err := reference.GetReference(clientsetscheme.Scheme, pgbouncer)
+	if err != nil {
+		return "", err
+	}
+	secret = &core.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: pgbouncer.Namespace,
+			Labels:    pgbouncer.OffshootLabels(),
+		},
+		Type: core.SecretTypeOpaque,
+		StringData: map[string]string{
+			appcat.KeyUsername: PgBouncerStatsUser,
+			appcat.KeyPassword: rand.GeneratePassword(),
+		},
+	}
+	core_util.EnsureOwnerReference(&secret.ObjectMeta, ref)
+	if _, err := c.Client.CoreV1().Secrets(pgbouncer.Namespace).Create(secret); err != nil {
+		return "", err
+	}
+	return secret.StringData[appcat.KeyPassword], nil
+}
+
+// ensurePgBouncerConfig writes pgbouncer.ini and the userlist of pgbouncer to the config secret, that is
+// mounted in the pods. The pods reload pgbouncer, once the change reaches the mounted files.
+func (c *Controller) ensurePgBouncerConfig(pgbouncer *api.PgBouncer) error {
+	upstreams, err := c.resolveUpstreams(pgbouncer)
+	if err != nil {
+		return err
+	}
+	statsPassword, err := c.ensurePgBouncerAuthSecret(pgbouncer)
+	if err != nil {
+		return err
+	}
+	users, err := c.pgbouncerUsers(pgbouncer, statsPassword)
+	if err != nil {
+		return err
+	}
+
+	ref, err := reference.GetReference(clientsetscheme.Scheme, pgbouncer)
+	if err != nil {
+		return err
+	}
+	meta := metav1.ObjectMeta{
+		Name:      pgbouncerConfigSecretName(pgbouncer),
+		Namespace: pgbouncer.Namespace,
+	}
+	_, _, err = core_util.CreateOrPatchSecret(c.Client, meta, func(in *core.Secret) *core.Secret {
+		in.Labels = pgbouncer.OffshootLabels()
+		core_util.EnsureOwnerReference(&in.ObjectMeta, ref)
+		in.Data = map[string][]byte{
+			PgBouncerConfigFile:   []byte(PgBouncerConf(pgbouncer, upstreams)),
+			PgBouncerUserListFile: []byte(PgBouncerUserList(users)),
+		}
+		if _, ca := pgbouncerServerTLS(pgbouncer, upstreams); ca != nil {
+			in.Data[PgBouncerServerCAFile] = ca
+		}
+		return in
+	})
+	return err
+}
@@ -0,0 +1,187 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	appcat "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
+)
+
+func newPgBouncer(databases ...api.Databases) *api.PgBouncer {
+	pgbouncer := &api.PgBouncer{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default"},
+		Spec:       api.PgBouncerSpec{Databases: databases},
+	}
+	pgbouncer.SetDefaults()
+	return pgbouncer
+}
+
+func TestPgBouncerConf(t *testing.T) {
+	const pgbouncerSection = "\n[pgbouncer]\n" +
+		"listen_addr = *\n" +
+		"listen_port = 5432\n" +
+		"auth_type = md5\n" +
+		"auth_file = /etc/pgbouncer/config/userlist.txt\n" +
+		"pool_mode = session\n" +
+		"stats_users = kubedb\n"
+	app := api.Databases{Alias: "app", DatabaseRef: appcat.AppReference{Name: "foo"}, DatabaseName: "app"}
+	other := api.Databases{Alias: "other", DatabaseRef: appcat.AppReference{Name: "bar"}, DatabaseName: "other db", UserName: "o'neil", Password: `p\w`}
+	plain := pgbouncerUpstream{host: "foo.default.svc", port: 5432, sslMode: "disable"}
+	verified := func(host, ca string) pgbouncerUpstream {
+		return pgbouncerUpstream{host: host, port: 5432, sslMode: "verify-full", caBundle: []byte(ca)}
+	}
+	cases := []struct {
+		name      string
+		databases []api.Databases
+		upstreams map[string]pgbouncerUpstream
+		want      string
+	}{
+		{
+			name:      "without tls",
+			databases: []api.Databases{app, other},
+			upstreams: map[string]pgbouncerUpstream{"app": plain, "other": {host: "bar.default.svc", port: 5433}},
+			want: "# generated by the operator from the PgBouncer spec\n[databases]\n" +
+				"app = host=foo.default.svc port=5432 dbname=app\n" +
+				`other = host=bar.default.svc port=5433 dbname='other db' user='o\'neil' password='p\\w'` + "\n" +
+				pgbouncerSection,
+		},
+		{
+			name:      "tls verified",
+			databases: []api.Databases{app, other},
+			upstreams: map[string]pgbouncerUpstream{"app": verified("foo.default.svc", "ca"), "other": verified("bar.default.svc", "ca\n")},
+			want: "# generated by the operator from the PgBouncer spec\n[databases]\n" +
+				"app = host=foo.default.svc port=5432 dbname=app\n" +
+				`other = host=bar.default.svc port=5432 dbname='other db' user='o\'neil' password='p\\w'` + "\n" +
+				pgbouncerSection +
+				"server_tls_sslmode = verify-full\n" +
+				"server_tls_ca_file = /etc/pgbouncer/config/server-ca.crt\n",
+		},
+		{
+			name:      "tls without ca",
+			databases: []api.Databases{app},
+			upstreams: map[string]pgbouncerUpstream{"app": {host: "foo.default.svc", port: 5432, sslMode: "require"}},
+			want: "# generated by the operator from the PgBouncer spec\n[databases]\n" +
+				"app = host=foo.default.svc port=5432 dbname=app\n" +
+				pgbouncerSection +
+				"server_tls_sslmode = require\n",
+		},
+		{
+			name:      "tls of some databases",
+			databases: []api.Databases{app, other},
+			upstreams: map[string]pgbouncerUpstream{"app": plain, "other": verified("bar.default.svc", "ca")},
+			want: "# generated by the operator from the PgBouncer spec\n[databases]\n" +
+				"app = host=foo.default.svc port=5432 dbname=app\n" +
+				`other = host=bar.default.svc port=5432 dbname='other db' user='o\'neil' password='p\\w'` + "\n" +
+				pgbouncerSection +
+				"server_tls_sslmode = prefer\n",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := PgBouncerConf(newPgBouncer(c.databases...), c.upstreams); got != c.want {
+				t.Errorf("expected\n%s\ngot\n%s", c.want, got)
+			}
+		})
+	}
+}
+
+func TestPgBouncerServerTLS(t *testing.T) {
+	pgbouncer := newPgBouncer(api.Databases{Alias: "a"}, api.Databases{Alias: "b"}, api.Databases{Alias: "c"})
+	upstreams := map[string]pgbouncerUpstream{
+		"a": {sslMode: "verify-full", caBundle: []byte("ca-1\n")},
+		"b": {sslMode: "verify-full", caBundle: []byte("ca-2")},
+		"c": {sslMode: "verify-full", caBundle: []byte("ca-1\n")},
+	}
+	mode, ca := pgbouncerServerTLS(pgbouncer, upstreams)
+	if mode != "verify-full" || string(ca) != "ca-1\nca-2\n" {
+		t.Errorf("expected verify-full with each CA once, got %s with %q", mode, ca)
+	}
+}
+
+func TestPgBouncerUserList(t *testing.T) {
+	cases := []struct {
+		name  string
+		users map[string]string
+		want  string
+	}{
+		{"empty", nil, ""},
+		{"sorted", map[string]string{"kubedb": "secret", "app": "md5abc"}, "\"app\" \"md5abc\"\n\"kubedb\" \"secret\"\n"},
+		{"quotes doubled", map[string]string{`a"b`: `p"w`}, "\"a\"\"b\" \"p\"\"w\"\n"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := PgBouncerUserList(c.users); got != c.want {
+				t.Errorf("expected %q, got %q", c.want, got)
+			}
+		})
+	}
+}
+
+func TestQuoteConnValue(t *testing.T) {
+	cases := []struct {
+		in, want string
+	}{
+		{"app", "app"},
+		{"", "''"},
+		{"my db", "'my db'"},
+		{"o'neil", `'o\'neil'`},
+		{`back\slash`, `'back\\slash'`},
+	}
+	for _, c := range cases {
+		if got := quoteConnValue(c.in); got != c.want {
+			t.Errorf("quoteConnValue(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestValidatePgBouncer(t *testing.T) {
+	cases := []struct {
+		name    string
+		modify  func(*api.PgBouncer)
+		wantErr string
+	}{
+		{"defaults", func(*api.PgBouncer) {}, ""},
+		{"admin users", func(p *api.PgBouncer) { p.Spec.ConnectionPool.AdminUsers = []string{"app"} }, ""},
+		{"admin user with comma", func(p *api.PgBouncer) { p.Spec.ConnectionPool.AdminUsers = []string{"app,other"} }, "adminUsers"},
+		{"reserved alias", func(p *api.PgBouncer) { p.Spec.Databases[0].Alias = "pgbouncer" }, "alias"},
+		{"password without user", func(p *api.PgBouncer) { p.Spec.Databases[0].Password = "secret" }, "UserName"},
+		{"auth user", func(p *api.PgBouncer) { p.Spec.ConnectionPool.AuthUser = "pgbouncer" }, ""},
+		{"auth user with newline", func(p *api.PgBouncer) { p.Spec.ConnectionPool.AuthUser = "pgbouncer\nadmin_users = app" }, "authUser"},
+		{"startup parameters", func(p *api.PgBouncer) {
+			p.Spec.ConnectionPool.IgnoreStartupParameters = "extra_float_digits,search_path"
+		}, ""},
+		{"startup parameters with space", func(p *api.PgBouncer) {
+			p.Spec.ConnectionPool.IgnoreStartupParameters = "extra_float_digits, search_path"
+		}, "ignoreStartupParameters"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			pgbouncer := newPgBouncer(api.Databases{Alias: "app", DatabaseRef: appcat.AppReference{Name: "foo"}, DatabaseName: "app"})
+			c.modify(pgbouncer)
+			err := validatePgBouncer(pgbouncer)
+			if c.wantErr == "" && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			} else if c.wantErr != "" && (err == nil || !strings.Contains(err.Error(), c.wantErr)) {
+				t.Errorf("expected error on %s, got %v", c.wantErr, err)
+			}
+		})
+	}
+}
@@ -83,6 +83,54 @@ func (c *Controller) create(postgres *api.Postgres) error {
 		return err
 	}
 
+	// ensure certificates, before the members are started with them
+	if err := c.ensureTLS(postgres); err != nil {
+		return fmt.Errorf("failed to issue certificates of Postgres %v/%v. Reason: %v", postgres.Namespace, postgres.Name, err)
+	}
+
+	// the StatefulSet is managed by the upgrade, while the data directories are upgraded to a new major version
+	if upgrading, err := c.ensureMajorVersionUpgrade(postgres); err != nil {
+		return fmt.Errorf("failed to upgrade Postgres %v/%v. Reason: %v", postgres.Namespace, postgres.Name, err)
+	} else if upgrading {
+		return nil
+	}
+
+	// the StatefulSet is recreated, once the data volumes are expanded
+	if recreating, err := c.ensureVolumeExpansion(postgres); err != nil {
+		return fmt.Errorf("failed to expand volumes of Postgres %v/%v. Reason: %v", postgres.Namespace, postgres.Name, err)
+	} else if recreating {
+		return nil
+	}
+
+	// the members are restarted with a rotated password, once it is set on the primary
+	if err := c.ensurePasswordRotation(postgres); err != nil {
+		return fmt.Errorf("failed to rotate password of Postgres %v/%v. Reason: %v", postgres.Namespace, postgres.Name, err)
+	}
+
+	// the members reload changed parameters, or are restarted with them, see AnnotationRestartParameters
+	if err := c.ensureParameters(postgres); err != nil {
+		return fmt.Errorf("failed to ensure parameters of Postgres %v/%v. Reason: %v", postgres.Namespace, postgres.Name, err)
+	}
+
+	// the primary copies the data of the source, before it is started
+	if err := c.ensureClone(postgres); err != nil {
+		return fmt.Errorf("failed to clone Postgres %v/%v. Reason: %v", postgres.Namespace, postgres.Name, err)
+	} else if clone := postgres.Status.Clone; clone != nil && clone.Phase == api.PostgresClonePhaseFailed {
+		return nil
+	}
+
+	// the archive is checked to be decryptable, before the primary restores it
+	if failed, err := c.checkInitDecryption(postgres); err != nil {
+		return fmt.Errorf("failed to read the archive to restore Postgres %v/%v from. Reason: %v", postgres.Namespace, postgres.Name, err)
+	} else if failed {
+		return nil
+	}
+
+	// the key of the archive is recorded, before the members encrypt with it
+	if err := c.ensureArchiveEncryption(postgres); err != nil {
+		return fmt.Errorf("failed to ensure encryption of the archive of Postgres %v/%v. Reason: %v", postgres.Namespace, postgres.Name, err)
+	}
+
 	// ensure database StatefulSet
 	postgresVersion, err := c.ExtClient.CatalogV1alpha1().PostgresVersions().Get(string(postgres.Spec.Version), metav1.GetOptions{})
 	if err != nil {
@@ -93,6 +141,10 @@ func (c *Controller) create(postgres *api.Postgres) error {
 		return err
 	}
 
+	if err := c.completeClone(postgres); err != nil {
+		return err
+	}
+
 	if vt1 == kutil.VerbCreated && vt2 == kutil.VerbCreated {
 		c.recorder.Event(
 			postgres,
@@ -157,6 +209,18 @@ func (c *Controller) create(postgres *api.Postgres) error {
 	}
 	postgres.Status = pg.Status
 
+	if err := c.ensureSwitchover(postgres); err != nil {
+		return fmt.Errorf("failed to request switchover of Postgres %v/%v. Reason: %v", postgres.Namespace, postgres.Name, err)
+	}
+
+	if err := c.ensureRollout(postgres); err != nil {
+		return fmt.Errorf("failed to restart members of Postgres %v/%v. Reason: %v", postgres.Namespace, postgres.Name, err)
+	}
+
+	if err := c.ensureBaseBackup(postgres); err != nil {
+		return fmt.Errorf("failed to schedule base backup of Postgres %v/%v. Reason: %v", postgres.Namespace, postgres.Name, err)
+	}
+
 	// Ensure Schedule backup
 	if err := c.ensureBackupScheduler(postgres); err != nil {
 		c.recorder.Eventf(
@@ -204,6 +268,10 @@ func (c *Controller) ensurePostgresNode(postgres *api.Postgres, postgresVersion
 		return kutil.VerbUnchanged, err
 	}
 
+	if err = c.ensureClientAuthentication(postgres); err != nil {
+		return kutil.VerbUnchanged, fmt.Errorf("failed to ensure client authentication rules. Reason: %v", err)
+	}
+
 	if c.EnableRBAC {
 		// Ensure Service account, role, rolebinding, and PSP for database statefulsets
 		if err := c.ensureDatabaseRBAC(postgres); err != nil {
@@ -216,6 +284,10 @@ func (c *Controller) ensurePostgresNode(postgres *api.Postgres, postgresVersion
 		return kutil.VerbUnchanged, err
 	}
 
+	if err := c.removeLegacyLeaderLock(postgres); err != nil {
+		return kutil.VerbUnchanged, err
+	}
+
 	return vt, nil
 }
 
@@ -265,6 +337,14 @@ func (c *Controller) initializeFromSnapshot(postgres *api.Postgres) error {
 		return err
 	}
 
+	// a snapshot that can not be decrypted fails, before the restore job is created
+	if reason, err := c.checkSnapshotDecryption(snapshot); err != nil {
+		return err
+	} else if reason != "" {
+		c.pushFailureEvent(postgres, reason)
+		return nil
+	}
+
 	secret, err := storage.NewOSMSecret(c.Client, snapshot.OSMSecretName(), snapshot.Namespace, snapshot.Spec.Backend)
 	if err != nil {
 		return err
@@ -0,0 +1,250 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	"kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1/util"
+
+	"github.com/appscode/go/encoding/json/types"
+	"github.com/appscode/go/log"
+	"github.com/lib/pq"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	meta_util "kmodules.xyz/client-go/meta"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+const (
+	EventReasonDatabaseCreated = "DatabaseCreated"
+	EventReasonDatabaseDropped = "DatabaseDropped"
+	EventReasonRoleCreated     = "RoleCreated"
+	EventReasonRoleDropped     = "RoleDropped"
+	EventReasonDriftDetected   = "DriftDetected"
+	EventReasonSyncFailed      = "SyncFailed"
+
+	// period at which PostgresDatabase and PostgresRole objects are checked for being out of sync
+	declarativeSyncPeriod = time.Minute
+	// PostgresDatabase and PostgresRole objects are compared with the server again after this duration
+	declarativeResyncPeriod = 5 * time.Minute
+)
+
+// ensurePostgresDatabase creates the database declared by pgdb in its Postgres, and brings the owner and
+// the extensions of the database in sync with the spec. Differences are corrected and reported as drift,
+// except for the encoding, which can not be changed once the database is created.
+func (c *Controller) ensurePostgresDatabase(pgdb *api.PostgresDatabase) error {
+	desired := pgdb.DeepCopy()
+	desired.SetDefaults()
+	spec := desired.Spec
+
+	postgres, err := c.runningPostgres(pgdb.Namespace, spec.DatabaseRef.Name)
+	if err != nil {
+		return err
+	} else if postgres == nil {
+		return c.updatePostgresDatabaseStatus(pgdb, api.PostgresObjectPhasePending,
+			fmt.Sprintf("waiting for Postgres %s to be running", spec.DatabaseRef.Name), nil)
+	}
+
+	drift, err := c.syncPostgresDatabase(pgdb, postgres, spec)
+	if err != nil {
+		c.recorder.Eventf(pgdb, core.EventTypeWarning, EventReasonSyncFailed, "failed to sync database %s. Reason: %v", spec.Name, err)
+		if uerr := c.updatePostgresDatabaseStatus(pgdb, api.PostgresObjectPhaseFailed, err.Error(), drift); uerr != nil {
+			return uerr
+		}
+		return err
+	}
+	if len(drift) > 0 {
+		c.recorder.Eventf(pgdb, core.EventTypeWarning, EventReasonDriftDetected, "database %s drifted from its spec: %s", spec.Name, strings.Join(drift, "; "))
+	}
+	return c.updatePostgresDatabaseStatus(pgdb, api.PostgresObjectPhaseReady, "", drift)
+}
+
+// syncPostgresDatabase creates or updates the database in postgres, and returns the differences found.
+func (c *Controller) syncPostgresDatabase(pgdb *api.PostgresDatabase, postgres *api.Postgres, spec api.PostgresDatabaseSpec) ([]string, error) {
+	db, err := c.openPostgres(postgres, "postgres")
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	var drift []string
+	var owner, encoding string
+	created := false
+	err = db.QueryRow("SELECT pg_get_userbyid(datdba), pg_encoding_to_char(encoding) FROM pg_database WHERE datname = $1", spec.Name).Scan(&owner, &encoding)
+	if err == sql.ErrNoRows {
+		// template0 allows any encoding, unlike the template1 that is used by default
+		stmt := fmt.Sprintf("CREATE DATABASE %s ENCODING %s TEMPLATE template0", pq.QuoteIdentifier(spec.Name), quoteLiteral(spec.Encoding))
+		if spec.Owner != "" {
+			stmt += " OWNER " + pq.QuoteIdentifier(spec.Owner)
+		}
+		if _, err := db.Exec(stmt); err != nil {
+			return nil, err
+		}
+		c.recorder.Eventf(pgdb, core.EventTypeNormal, EventReasonDatabaseCreated, "database %s is created in Postgres %s", spec.Name, postgres.Name)
+		created = true
+	} else if err != nil {
+		return nil, err
+	} else {
+		var reassign bool
+		if drift, reassign = databaseDrift(spec, owner, encoding); reassign {
+			if _, err := db.Exec(fmt.Sprintf("ALTER DATABASE %s OWNER TO %s", pq.QuoteIdentifier(spec.Name), pq.QuoteIdentifier(spec.Owner))); err != nil {
+				return drift, err
+			}
+		}
+	}
+	if len(spec.Extensions) == 0 {
+		return drift, nil
+	}
+
+	// extensions are created in the database itself
+	target, err := c.openPostgres(postgres, spec.Name)
+	if err != nil {
+		return drift, err
+	}
+	defer target.Close()
+	for _, extension := range spec.Extensions {
+		var found bool
+		if err := target.QueryRow("SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = $1)", extension).Scan(&found); err != nil {
+			return drift, err
+		}
+		if found {
+			continue
+		}
+		if !created {
+			drift = append(drift, fmt.Sprintf("extension %s is missing", extension))
+		}
+		if _, err := target.Exec("CREATE EXTENSION IF NOT EXISTS " + pq.QuoteIdentifier(extension)); err != nil {
+			return drift, err
+		}
+	}
+	return drift, nil
+}
+
+// databaseDrift returns how the existing database with owner and encoding differs from spec, and whether it has
+// to be reassigned to the owner of spec.
+func databaseDrift(spec api.PostgresDatabaseSpec, owner, encoding string) (drift []string, reassign bool) {
+	if spec.Owner != "" && owner != spec.Owner {
+		drift = append(drift, fmt.Sprintf("owner is %s instead of %s", owner, spec.Owner))
+		reassign = true
+	}
+	if !strings.EqualFold(encoding, spec.Encoding) {
+		drift = append(drift, fmt.Sprintf("encoding is %s instead of %s, which can not be changed", encoding, spec.Encoding))
+	}
+	return drift, reassign
+}
+
+// dropPostgresDatabase drops the database of pgdb, if its deletion policy is Delete. The database is kept,
+// if its Postgres does not exist anymore.
+func (c *Controller) dropPostgresDatabase(pgdb *api.PostgresDatabase) error {
+	desired := pgdb.DeepCopy()
+	desired.SetDefaults()
+	spec := desired.Spec
+	if spec.DeletionPolicy != api.PostgresObjectDeletionPolicyDelete {
+		return nil
+	}
+
+	postgres, err := c.postgresToDropFrom(pgdb.Namespace, spec.DatabaseRef.Name)
+	if err != nil || postgres == nil {
+		return err
+	}
+
+	db, err := c.openPostgres(postgres, "postgres")
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	// a database can not be dropped, while there are connections to it
+	if _, err := db.Exec("SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE datname = $1 AND pid <> pg_backend_pid()", spec.Name); err != nil {
+		return err
+	}
+	if _, err := db.Exec("DROP DATABASE IF EXISTS " + pq.QuoteIdentifier(spec.Name)); err != nil {
+		return err
+	}
+	c.recorder.Eventf(pgdb, core.EventTypeNormal, EventReasonDatabaseDropped, "database %s is dropped from Postgres %s", spec.Name, postgres.Name)
+	return nil
+}
+
+// runningPostgres returns the Postgres name in namespace, or nil if it does not exist or is not running.
+func (c *Controller) runningPostgres(namespace, name string) (*api.Postgres, error) {
+	postgres, err := c.pgLister.Postgreses(namespace).Get(name)
+	if kerr.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if postgres.DeletionTimestamp != nil || postgres.Status.Phase != api.DatabasePhaseRunning {
+		return nil, nil
+	}
+	return postgres, nil
+}
+
+// postgresToDropFrom returns the Postgres name in namespace, that a database object is dropped from. It returns
+// nil, if the Postgres does not exist anymore or is being deleted, and error, while it is not running.
+func (c *Controller) postgresToDropFrom(namespace, name string) (*api.Postgres, error) {
+	postgres, err := c.ExtClient.KubedbV1alpha1().Postgreses(namespace).Get(name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		log.Infof("Postgres %s/%s does not exist anymore, nothing is dropped from it", namespace, name)
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if postgres.DeletionTimestamp != nil {
+		return nil, nil
+	}
+	if postgres.Status.Phase != api.DatabasePhaseRunning {
+		return nil, fmt.Errorf("nothing can be dropped from Postgres %s/%s, while it is %s", namespace, name, postgres.Status.Phase)
+	}
+	return postgres, nil
+}
+
+func (c *Controller) updatePostgresDatabaseStatus(pgdb *api.PostgresDatabase, phase api.PostgresObjectPhase, reason string, drift []string) error {
+	pg, err := util.UpdatePostgresDatabaseStatus(c.ExtClient.KubedbV1alpha1(), pgdb, func(in *api.PostgresDatabaseStatus) *api.PostgresDatabaseStatus {
+		in.Phase = phase
+		in.Reason = reason
+		in.Drift = drift
+		in.LastSyncTime = &metav1.Time{Time: time.Now()}
+		in.ObservedGeneration = types.NewIntHash(pgdb.Generation, meta_util.GenerationHash(pgdb))
+		return in
+	})
+	if err != nil {
+		return err
+	}
+	pgdb.Status = pg.Status
+	return nil
+}
+
+// syncPostgresDatabases processes the PostgresDatabase objects again, that are not in sync or have not been
+// compared with the server for declarativeResyncPeriod, so that drift is detected and corrected.
+func (c *Controller) syncPostgresDatabases() {
+	objs, err := c.pgDatabaseLister.List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	for _, pgdb := range objs {
+		if pgdb.Status.Phase != api.PostgresObjectPhaseReady || pgdb.Status.LastSyncTime == nil ||
+			time.Since(pgdb.Status.LastSyncTime.Time) >= declarativeResyncPeriod {
+			queue.Enqueue(c.pgDatabaseQueue.GetQueue(), pgdb)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+)
+
+func TestDatabaseDrift(t *testing.T) {
+	cases := []struct {
+		name         string
+		spec         api.PostgresDatabaseSpec
+		owner        string
+		encoding     string
+		want         []string
+		wantReassign bool
+	}{
+		{"in sync", api.PostgresDatabaseSpec{Owner: "app", Encoding: "UTF8"}, "app", "UTF8", nil, false},
+		{"encoding differs in case only", api.PostgresDatabaseSpec{Owner: "app", Encoding: "utf8"}, "app", "UTF8", nil, false},
+		{"owner not declared", api.PostgresDatabaseSpec{Encoding: "UTF8"}, "postgres", "UTF8", nil, false},
+		{
+			"owner differs",
+			api.PostgresDatabaseSpec{Owner: "app", Encoding: "UTF8"}, "postgres", "UTF8",
+			[]string{"owner is postgres instead of app"}, true,
+		},
+		{
+			"encoding differs",
+			api.PostgresDatabaseSpec{Owner: "app", Encoding: "UTF8"}, "app", "LATIN1",
+			[]string{"encoding is LATIN1 instead of UTF8, which can not be changed"}, false,
+		},
+		{
+			"owner and encoding differ",
+			api.PostgresDatabaseSpec{Owner: "app", Encoding: "UTF8"}, "postgres", "LATIN1",
+			[]string{"owner is postgres instead of app", "encoding is LATIN1 instead of UTF8, which can not be changed"}, true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			drift, reassign := databaseDrift(c.spec, c.owner, c.encoding)
+			if !reflect.DeepEqual(drift, c.want) {
+				t.Errorf("databaseDrift() drift = %q, want %q", drift, c.want)
+			}
+			if reassign != c.wantReassign {
+				t.Errorf("databaseDrift() reassign = %v, want %v", reassign, c.wantReassign)
+			}
+		})
+	}
+}
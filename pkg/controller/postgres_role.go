@@ -0,0 +1,503 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	"kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1/util"
+	le "kubedb.dev/postgres/pkg/leader_election"
+
+	"github.com/appscode/go/crypto/rand"
+	"github.com/appscode/go/encoding/json/types"
+	"github.com/appscode/go/log"
+	"github.com/lib/pq"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	clientsetscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/reference"
+	core_util "kmodules.xyz/client-go/core/v1"
+	meta_util "kmodules.xyz/client-go/meta"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+var (
+	// role attributes, that are declared as privileges
+	roleAttributes = []struct {
+		privilege api.PostgresRolePrivilege
+		keyword   string
+	}{
+		{api.PostgresRolePrivilegeSuperuser, "SUPERUSER"},
+		{api.PostgresRolePrivilegeCreateDB, "CREATEDB"},
+		{api.PostgresRolePrivilegeCreateRole, "CREATEROLE"},
+		{api.PostgresRolePrivilegeReplication, "REPLICATION"},
+		{api.PostgresRolePrivilegeBypassRLS, "BYPASSRLS"},
+	}
+	databasePrivileges = []string{"CREATE", "CONNECT", "TEMPORARY"}
+	tablePrivileges    = []string{"SELECT", "INSERT", "UPDATE", "DELETE", "TRUNCATE", "REFERENCES", "TRIGGER"}
+)
+
+// ensurePostgresRole creates the role declared by role in its Postgres, and brings its attributes, password,
+// memberships and grants in sync with the spec. Differences are corrected and reported as drift.
+// Memberships that are not declared are revoked, while grants are only added.
+func (c *Controller) ensurePostgresRole(role *api.PostgresRole) error {
+	desired := role.DeepCopy()
+	desired.SetDefaults()
+	spec := desired.Spec
+
+	if err := validatePostgresRole(spec); err != nil {
+		// user error so just record error and don't retry.
+		c.recorder.Event(role, core.EventTypeWarning, EventReasonSyncFailed, err.Error())
+		return c.updatePostgresRoleStatus(role, api.PostgresObjectPhaseFailed, err.Error(), nil)
+	}
+
+	postgres, err := c.runningPostgres(role.Namespace, spec.DatabaseRef.Name)
+	if err != nil {
+		return err
+	} else if postgres == nil {
+		return c.updatePostgresRoleStatus(role, api.PostgresObjectPhasePending,
+			fmt.Sprintf("waiting for Postgres %s to be running", spec.DatabaseRef.Name), nil)
+	}
+
+	drift, err := c.syncPostgresRole(role, postgres, spec)
+	if err != nil {
+		c.recorder.Eventf(role, core.EventTypeWarning, EventReasonSyncFailed, "failed to sync role %s. Reason: %v", spec.Name, err)
+		if uerr := c.updatePostgresRoleStatus(role, api.PostgresObjectPhaseFailed, err.Error(), drift); uerr != nil {
+			return uerr
+		}
+		return err
+	}
+	if len(drift) > 0 {
+		c.recorder.Eventf(role, core.EventTypeWarning, EventReasonDriftDetected, "role %s drifted from its spec: %s", spec.Name, strings.Join(drift, "; "))
+	}
+	return c.updatePostgresRoleStatus(role, api.PostgresObjectPhaseReady, "", drift)
+}
+
+func validatePostgresRole(spec api.PostgresRoleSpec) error {
+	for _, privilege := range spec.Privileges {
+		found := false
+		for _, attr := range roleAttributes {
+			found = found || attr.privilege == privilege
+		}
+		if !found {
+			return fmt.Errorf(`spec.privileges "%s" invalid`, privilege)
+		}
+	}
+	for _, grant := range spec.Grants {
+		if grant.Database == "" {
+			return fmt.Errorf("spec.grants.database is missing")
+		}
+		if _, err := grantPrivileges(grant); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// grantPrivileges returns the privileges of grant in upper case, with ALL expanded to the privileges it stands for.
+func grantPrivileges(grant api.PostgresGrant) ([]string, error) {
+	valid := databasePrivileges
+	if grant.Schema != "" {
+		valid = tablePrivileges
+	}
+	var out []string
+	for _, privilege := range grant.Privileges {
+		privilege = strings.ToUpper(strings.TrimSpace(privilege))
+		switch privilege {
+		case "ALL", "ALL PRIVILEGES":
+			out = append(out, valid...)
+			continue
+		case "TEMP":
+			privilege = "TEMPORARY"
+		}
+		found := false
+		for _, v := range valid {
+			found = found || v == privilege
+		}
+		if !found {
+			return nil, fmt.Errorf(`spec.grants.privileges "%s" invalid for database %s, schema "%s"`, privilege, grant.Database, grant.Schema)
+		}
+		out = append(out, privilege)
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("spec.grants.privileges for database %s, schema \"%s\" is missing", grant.Database, grant.Schema)
+	}
+	return out, nil
+}
+
+// syncPostgresRole creates or updates the role in postgres, and returns the differences found.
+func (c *Controller) syncPostgresRole(role *api.PostgresRole, postgres *api.Postgres, spec api.PostgresRoleSpec) ([]string, error) {
+	password := ""
+	if spec.Login {
+		var err error
+		if password, err = c.ensureRolePassword(role, spec); err != nil {
+			return nil, err
+		}
+	}
+
+	db, err := c.openPostgres(postgres, "postgres")
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	current := &roleState{}
+	err = db.QueryRow("SELECT rolcanlogin, rolsuper, rolcreatedb, rolcreaterole, rolreplication, rolbypassrls, rolconnlimit, COALESCE(rolpassword, '') FROM pg_authid WHERE rolname = $1", spec.Name).
+		Scan(&current.login, &current.attributes[0], &current.attributes[1], &current.attributes[2], &current.attributes[3], &current.attributes[4], &current.connLimit, &current.password)
+	exists := err == nil
+	if err == sql.ErrNoRows {
+		current = nil
+	} else if err != nil {
+		return nil, err
+	}
+	options, drift := roleOptions(spec, password, current)
+
+	if !exists {
+		if _, err := db.Exec(fmt.Sprintf("CREATE ROLE %s WITH %s", pq.QuoteIdentifier(spec.Name), strings.Join(options, " "))); err != nil {
+			return nil, err
+		}
+		c.recorder.Eventf(role, core.EventTypeNormal, EventReasonRoleCreated, "role %s is created in Postgres %s", spec.Name, postgres.Name)
+	} else if len(drift) > 0 {
+		if _, err := db.Exec(fmt.Sprintf("ALTER ROLE %s WITH %s", pq.QuoteIdentifier(spec.Name), strings.Join(options, " "))); err != nil {
+			return drift, err
+		}
+	}
+
+	memberDrift, err := syncRoleMemberships(db, spec)
+	if exists {
+		drift = append(drift, memberDrift...)
+	}
+	if err != nil {
+		return drift, err
+	}
+	for _, grant := range spec.Grants {
+		grantDrift, err := c.syncRoleGrant(db, postgres, spec.Name, grant)
+		if exists {
+			drift = append(drift, grantDrift...)
+		}
+		if err != nil {
+			return drift, err
+		}
+	}
+	return drift, nil
+}
+
+// roleState is the state of an existing role, as it is recorded in pg_authid.
+type roleState struct {
+	login bool
+	// in the order of roleAttributes
+	attributes [5]bool
+	connLimit  int32
+	// md5 hash of the password
+	password string
+}
+
+// roleOptions returns the options of CREATE ROLE or ALTER ROLE for spec with password, and how current differs
+// from them. current is nil, if the role does not exist.
+func roleOptions(spec api.PostgresRoleSpec, password string, current *roleState) (options, drift []string) {
+	options = []string{"NOLOGIN"}
+	if spec.Login {
+		options[0] = "LOGIN"
+	}
+	if current != nil && current.login != spec.Login {
+		drift = append(drift, fmt.Sprintf("login is %v instead of %v", current.login, spec.Login))
+	}
+	for i, attr := range roleAttributes {
+		declared := false
+		for _, privilege := range spec.Privileges {
+			declared = declared || privilege == attr.privilege
+		}
+		if declared {
+			options = append(options, attr.keyword)
+		} else {
+			options = append(options, "NO"+attr.keyword)
+		}
+		if current != nil && current.attributes[i] != declared {
+			drift = append(drift, fmt.Sprintf("privilege %s is %v instead of %v", attr.privilege, current.attributes[i], declared))
+		}
+	}
+	limit := int32(-1)
+	if spec.ConnectionLimit != nil {
+		limit = *spec.ConnectionLimit
+	}
+	options = append(options, fmt.Sprintf("CONNECTION LIMIT %d", limit))
+	if current != nil && current.connLimit != limit {
+		drift = append(drift, fmt.Sprintf("connection limit is %d instead of %d", current.connLimit, limit))
+	}
+	if spec.Login {
+		// the password is sent hashed, so that it does not show up in the logs of postgres
+		expected := le.MD5Password(spec.Name, password)
+		options = append(options, "PASSWORD "+quoteLiteral(expected))
+		if current != nil && current.password != expected {
+			drift = append(drift, fmt.Sprintf("password differs from secret %s", spec.PasswordSecret.Name))
+		}
+	}
+	return options, drift
+}
+
+// syncRoleMemberships grants the memberships of the role, that are declared in spec, and revokes the others.
+func syncRoleMemberships(db *sql.DB, spec api.PostgresRoleSpec) ([]string, error) {
+	rows, err := db.Query("SELECT r.rolname FROM pg_auth_members m JOIN pg_roles r ON r.oid = m.roleid JOIN pg_roles u ON u.oid = m.member WHERE u.rolname = $1", spec.Name)
+	if err != nil {
+		return nil, err
+	}
+	current := map[string]bool{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		current[name] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var drift []string
+	for _, group := range spec.MemberOf {
+		if current[group] {
+			delete(current, group)
+			continue
+		}
+		drift = append(drift, fmt.Sprintf("membership in %s is missing", group))
+		if _, err := db.Exec(fmt.Sprintf("GRANT %s TO %s", pq.QuoteIdentifier(group), pq.QuoteIdentifier(spec.Name))); err != nil {
+			return drift, err
+		}
+	}
+	for group := range current {
+		drift = append(drift, fmt.Sprintf("membership in %s is not declared", group))
+		if _, err := db.Exec(fmt.Sprintf("REVOKE %s FROM %s", pq.QuoteIdentifier(group), pq.QuoteIdentifier(spec.Name))); err != nil {
+			return drift, err
+		}
+	}
+	return drift, nil
+}
+
+// syncRoleGrant grants the privileges of grant to the role, that it is missing. Privileges on the tables of a schema
+// are granted on the tables created later by the owner of the schema as well.
+func (c *Controller) syncRoleGrant(db *sql.DB, postgres *api.Postgres, role string, grant api.PostgresGrant) ([]string, error) {
+	privileges, err := grantPrivileges(grant)
+	if err != nil {
+		return nil, err
+	}
+
+	var drift, missing []string
+	if grant.Schema == "" {
+		for _, privilege := range privileges {
+			var granted bool
+			if err := db.QueryRow("SELECT has_database_privilege($1, $2, $3)", role, grant.Database, privilege).Scan(&granted); err != nil {
+				return nil, err
+			}
+			if !granted {
+				missing = append(missing, privilege)
+			}
+		}
+		if len(missing) == 0 {
+			return nil, nil
+		}
+		drift = append(drift, fmt.Sprintf("privileges %s on database %s are missing", strings.Join(missing, ", "), grant.Database))
+		_, err := db.Exec(fmt.Sprintf("GRANT %s ON DATABASE %s TO %s", strings.Join(missing, ", "), pq.QuoteIdentifier(grant.Database), pq.QuoteIdentifier(role)))
+		return drift, err
+	}
+
+	// privileges on tables are granted in the database of the tables
+	target, err := c.openPostgres(postgres, grant.Database)
+	if err != nil {
+		return nil, err
+	}
+	defer target.Close()
+
+	schema := pq.QuoteIdentifier(grant.Schema)
+	var owner string
+	var usage bool
+	if err := target.QueryRow("SELECT pg_get_userbyid(nspowner), has_schema_privilege($2, oid, 'USAGE') FROM pg_namespace WHERE nspname = $1", grant.Schema, role).
+		Scan(&owner, &usage); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("schema %s does not exist in database %s", grant.Schema, grant.Database)
+		}
+		return nil, err
+	}
+	if !usage {
+		drift = append(drift, fmt.Sprintf("usage of schema %s in database %s is missing", grant.Schema, grant.Database))
+		if _, err := target.Exec(fmt.Sprintf("GRANT USAGE ON SCHEMA %s TO %s", schema, pq.QuoteIdentifier(role))); err != nil {
+			return drift, err
+		}
+	}
+	for _, privilege := range privileges {
+		var tables int
+		if err := target.QueryRow("SELECT count(*) FROM pg_tables WHERE schemaname = $1 AND NOT has_table_privilege($2, format('%I.%I', schemaname, tablename), $3)",
+			grant.Schema, role, privilege).Scan(&tables); err != nil {
+			return drift, err
+		}
+		if tables > 0 {
+			missing = append(missing, privilege)
+		}
+	}
+	if len(missing) > 0 {
+		drift = append(drift, fmt.Sprintf("privileges %s on tables of schema %s in database %s are missing", strings.Join(missing, ", "), grant.Schema, grant.Database))
+		if _, err := target.Exec(fmt.Sprintf("GRANT %s ON ALL TABLES IN SCHEMA %s TO %s", strings.Join(missing, ", "), schema, pq.QuoteIdentifier(role))); err != nil {
+			return drift, err
+		}
+	}
+	_, err = target.Exec(fmt.Sprintf("ALTER DEFAULT PRIVILEGES FOR ROLE %s IN SCHEMA %s GRANT %s ON TABLES TO %s",
+		pq.QuoteIdentifier(owner), schema, strings.Join(privileges, ", "), pq.QuoteIdentifier(role)))
+	return drift, err
+}
+
+// ensureRolePassword returns the password of the role from its password secret. The secret is created with a
+// random password, if it does not exist.
+func (c *Controller) ensureRolePassword(role *api.PostgresRole, spec api.PostgresRoleSpec) (string, error) {
+	secret, err := c.Client.CoreV1().Secrets(role.Namespace).Get(spec.PasswordSecret.Name, metav1.GetOptions{})
+	if err == nil {
+		password := string(secret.Data[core.BasicAuthPasswordKey])
+		if password == "" {
+			return "", fmt.Errorf("secret %s has no %s", secret.Name, core.BasicAuthPasswordKey)
+		}
+		return password, nil
+	} else if !kerr.IsNotFound(err) {
+		return "", err
+	}
+
+	ref, err := reference.GetReference(clientsetscheme.Scheme, role)
+	if err != nil {
+		return "", err
+	}
+	password := rand.GeneratePassword()
+	secret = &core.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      spec.PasswordSecret.Name,
+			Namespace: role.Namespace,
+			Labels:    role.OffshootLabels(),
+		},
+		Type: core.SecretTypeBasicAuth,
+		Data: map[string][]byte{
+			core.BasicAuthUsernameKey: []byte(spec.Name),
+			core.BasicAuthPasswordKey: []byte(password),
+		},
+	}
+	core_util.EnsureOwnerReference(&secret.ObjectMeta, ref)
+	if _, err := c.Client.CoreV1().Secrets(role.Namespace).Create(secret); err != nil {
+		return "", err
+	}
+	return password, nil
+}
+
+// dropPostgresRole drops the role of role, if its deletion policy is Delete. The objects owned by the role are
+// reassigned to the superuser in every database. The role is kept, if its Postgres does not exist anymore.
+func (c *Controller) dropPostgresRole(role *api.PostgresRole) error {
+	desired := role.DeepCopy()
+	desired.SetDefaults()
+	spec := desired.Spec
+	if spec.DeletionPolicy != api.PostgresObjectDeletionPolicyDelete {
+		return nil
+	}
+
+	postgres, err := c.postgresToDropFrom(role.Namespace, spec.DatabaseRef.Name)
+	if err != nil || postgres == nil {
+		return err
+	}
+	db, err := c.openPostgres(postgres, "postgres")
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	var exists bool
+	if err := db.QueryRow("SELECT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = $1)", spec.Name).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	rows, err := db.Query("SELECT datname FROM pg_database WHERE datallowconn")
+	if err != nil {
+		return err
+	}
+	var databases []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return err
+		}
+		databases = append(databases, name)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	// objects and privileges of the role in a database can only be dropped in that database
+	for _, database := range databases {
+		target, err := c.openPostgres(postgres, database)
+		if err != nil {
+			return err
+		}
+		_, err = target.Exec(fmt.Sprintf("REASSIGN OWNED BY %s TO CURRENT_USER", pq.QuoteIdentifier(spec.Name)))
+		if err == nil {
+			_, err = target.Exec(fmt.Sprintf("DROP OWNED BY %s", pq.QuoteIdentifier(spec.Name)))
+		}
+		target.Close()
+		if err != nil {
+			return fmt.Errorf("failed to drop objects of role %s in database %s. Reason: %v", spec.Name, database, err)
+		}
+	}
+	if _, err := db.Exec("DROP ROLE IF EXISTS " + pq.QuoteIdentifier(spec.Name)); err != nil {
+		return err
+	}
+	c.recorder.Eventf(role, core.EventTypeNormal, EventReasonRoleDropped, "role %s is dropped from Postgres %s", spec.Name, postgres.Name)
+	return nil
+}
+
+func (c *Controller) updatePostgresRoleStatus(role *api.PostgresRole, phase api.PostgresObjectPhase, reason string, drift []string) error {
+	r, err := util.UpdatePostgresRoleStatus(c.ExtClient.KubedbV1alpha1(), role, func(in *api.PostgresRoleStatus) *api.PostgresRoleStatus {
+		in.Phase = phase
+		in.Reason = reason
+		in.Drift = drift
+		in.LastSyncTime = &metav1.Time{Time: time.Now()}
+		in.ObservedGeneration = types.NewIntHash(role.Generation, meta_util.GenerationHash(role))
+		return in
+	})
+	if err != nil {
+		return err
+	}
+	role.Status = r.Status
+	return nil
+}
+
+// syncPostgresRoles processes the PostgresRole objects again, that are not in sync or have not been
+// compared with the server for declarativeResyncPeriod, so that drift is detected and corrected.
+func (c *Controller) syncPostgresRoles() {
+	objs, err := c.pgRoleLister.List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	for _, role := range objs {
+		if role.Status.Phase != api.PostgresObjectPhaseReady || role.Status.LastSyncTime == nil ||
+			time.Since(role.Status.LastSyncTime.Time) >= declarativeResyncPeriod {
+			queue.Enqueue(c.pgRoleQueue.GetQueue(), role)
+		}
+	}
+}
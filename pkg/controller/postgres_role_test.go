@@ -0,0 +1,108 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	le "kubedb.dev/postgres/pkg/leader_election"
+
+	core "k8s.io/api/core/v1"
+)
+
+func TestRoleOptions(t *testing.T) {
+	limit := int32(10)
+	hash := le.MD5Password("app", "secret")
+	login := api.PostgresRoleSpec{
+		Name:            "app",
+		Login:           true,
+		Privileges:      []api.PostgresRolePrivilege{api.PostgresRolePrivilegeCreateDB},
+		ConnectionLimit: &limit,
+		PasswordSecret:  &core.LocalObjectReference{Name: "app-auth"},
+	}
+	loginOptions := []string{"LOGIN", "NOSUPERUSER", "CREATEDB", "NOCREATEROLE", "NOREPLICATION", "NOBYPASSRLS", "CONNECTION LIMIT 10", "PASSWORD '" + hash + "'"}
+	inSync := func() *roleState {
+		return &roleState{login: true, attributes: [5]bool{false, true, false, false, false}, connLimit: 10, password: hash}
+	}
+
+	cases := []struct {
+		name        string
+		spec        api.PostgresRoleSpec
+		current     func() *roleState
+		wantOptions []string
+		wantDrift   []string
+	}{
+		{
+			name:        "group role",
+			spec:        api.PostgresRoleSpec{Name: "readers"},
+			wantOptions: []string{"NOLOGIN", "NOSUPERUSER", "NOCREATEDB", "NOCREATEROLE", "NOREPLICATION", "NOBYPASSRLS", "CONNECTION LIMIT -1"},
+		},
+		{
+			name:        "role does not exist",
+			spec:        login,
+			wantOptions: loginOptions,
+		},
+		{
+			name:        "in sync",
+			spec:        login,
+			current:     inSync,
+			wantOptions: loginOptions,
+		},
+		{
+			name: "drifted",
+			spec: login,
+			current: func() *roleState {
+				current := inSync()
+				current.login = false
+				current.attributes = [5]bool{true, false, false, false, false}
+				current.connLimit = -1
+				current.password = le.MD5Password("app", "old")
+				return current
+			},
+			wantOptions: loginOptions,
+			wantDrift: []string{
+				"login is false instead of true",
+				"privilege Superuser is true instead of false",
+				"privilege CreateDB is false instead of true",
+				"connection limit is -1 instead of 10",
+				"password differs from secret app-auth",
+			},
+		},
+		{
+			name:        "password of a group role is ignored",
+			spec:        api.PostgresRoleSpec{Name: "readers"},
+			current:     func() *roleState { return &roleState{connLimit: -1, password: hash} },
+			wantOptions: []string{"NOLOGIN", "NOSUPERUSER", "NOCREATEDB", "NOCREATEROLE", "NOREPLICATION", "NOBYPASSRLS", "CONNECTION LIMIT -1"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var current *roleState
+			if c.current != nil {
+				current = c.current()
+			}
+			options, drift := roleOptions(c.spec, "secret", current)
+			if !reflect.DeepEqual(options, c.wantOptions) {
+				t.Errorf("roleOptions() options = %q, want %q", options, c.wantOptions)
+			}
+			if !reflect.DeepEqual(drift, c.wantDrift) {
+				t.Errorf("roleOptions() drift = %q, want %q", drift, c.wantDrift)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	le "kubedb.dev/postgres/pkg/leader_election"
 
 	apps "k8s.io/api/apps/v1"
+	coordination "k8s.io/api/coordination/v1"
 	core "k8s.io/api/core/v1"
 	policy_v1beta1 "k8s.io/api/policy/v1beta1"
 	rbac "k8s.io/api/rbac/v1beta1"
@@ -58,7 +59,7 @@ func (c *Controller) ensureRole(db *api.Postgres, pspName string) error {
 				{
 					APIGroups: []string{core.GroupName},
 					Resources: []string{"pods"},
-					Verbs:     []string{"list", "patch"},
+					Verbs:     []string{"get", "list", "patch", "delete"},
 				},
 				{
 					APIGroups: []string{core.GroupName},
@@ -71,6 +72,44 @@ func (c *Controller) ensureRole(db *api.Postgres, pspName string) error {
 					Verbs:         []string{"get", "update"},
 					ResourceNames: []string{le.GetLeaderLockName(db.OffshootName())},
 				},
+				{
+					APIGroups: []string{coordination.GroupName},
+					Resources: []string{"leases"},
+					Verbs:     []string{"create"},
+				},
+				{
+					APIGroups:     []string{coordination.GroupName},
+					Resources:     []string{"leases"},
+					Verbs:         []string{"get", "update"},
+					ResourceNames: []string{le.GetLeaderLockName(db.OffshootName())},
+				},
+				{
+					APIGroups: []string{core.GroupName},
+					Resources: []string{"events"},
+					// the event recorder of the sidecar patches events, that it aggregates
+					Verbs: []string{"create", "patch"},
+				},
+				{
+					APIGroups:     []string{api.SchemeGroupVersion.Group},
+					Resources:     []string{api.ResourcePluralPostgres},
+					Verbs:         []string{"get"},
+					ResourceNames: []string{db.Name},
+				},
+				{
+					APIGroups:     []string{api.SchemeGroupVersion.Group},
+					Resources:     []string{api.ResourcePluralPostgres + "/status"},
+					Verbs:         []string{"update"},
+					ResourceNames: []string{db.Name},
+				},
+			}
+			if db.Spec.DatabaseSecret != nil {
+				// the primary reads the new password from the database secret, when the password is rotated
+				in.Rules = append(in.Rules, rbac.PolicyRule{
+					APIGroups:     []string{core.GroupName},
+					Resources:     []string{"secrets"},
+					Verbs:         []string{"get"},
+					ResourceNames: []string{db.Spec.DatabaseSecret.SecretName},
+				})
 			}
 			if pspName != "" {
 				pspRule := rbac.PolicyRule{
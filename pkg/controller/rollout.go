@@ -0,0 +1,278 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	"kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1/util"
+	le "kubedb.dev/postgres/pkg/leader_election"
+
+	"github.com/appscode/go/log"
+	"github.com/appscode/go/types"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+const (
+	EventReasonRollingRestart       = "RollingRestart"
+	EventReasonRollingRestartFailed = "RollingRestartFailed"
+
+	// period at which the members of each Postgres are checked for outdated revisions
+	rolloutSyncPeriod = 15 * time.Second
+	// maximum duration for a restarted member to become ready and catch up, before the rollout is halted
+	rolloutMemberTimeout = 10 * time.Minute
+)
+
+// ensureRollout restarts the members of postgres that do not run the update revision of its StatefulSet.
+// The StatefulSet uses the OnDelete strategy, so that the members are restarted here one at a time: the replicas
+// first, each after the previously restarted member is ready and streaming again, and the primary last, after
+// it has been switched over to a replica. It is left to the user, if spec.updateStrategy is OnDelete.
+func (c *Controller) ensureRollout(postgres *api.Postgres) error {
+	if postgres.Spec.UpdateStrategy.Type == apps.OnDeleteStatefulSetStrategyType {
+		return nil
+	}
+	statefulSet, err := c.Client.AppsV1().StatefulSets(postgres.Namespace).Get(postgres.OffshootName(), metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if statefulSet.Status.ObservedGeneration < statefulSet.Generation || statefulSet.Status.UpdateRevision == "" {
+		// the update revision is not known yet
+		return nil
+	}
+	revision := statefulSet.Status.UpdateRevision
+
+	podList, err := c.Client.CoreV1().Pods(postgres.Namespace).List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(postgres.OffshootSelectors()).String(),
+	})
+	if err != nil {
+		return err
+	}
+	pods := podList.Items
+	outdated := map[string]bool{}
+	for _, pod := range pods {
+		if pod.Labels[apps.ControllerRevisionHashLabelKey] != revision {
+			outdated[pod.Name] = true
+		}
+	}
+
+	rollout := postgres.Status.Rollout
+	if len(outdated) == 0 {
+		if rollout != nil && rollout.Phase == api.RolloutPhaseRunning {
+			msg := fmt.Sprintf("every member is restarted with revision %s", revision)
+			c.recorder.Event(postgres, core.EventTypeNormal, EventReasonRollingRestart, msg)
+			return c.updateRollout(postgres, revision, "", api.RolloutPhaseSucceeded, msg)
+		}
+		return nil
+	}
+	if rollout == nil || rollout.Revision != revision {
+		msg := fmt.Sprintf("restarting %d members with revision %s", len(outdated), revision)
+		c.recorder.Event(postgres, core.EventTypeNormal, EventReasonRollingRestart, msg)
+		if err := c.updateRollout(postgres, revision, "", api.RolloutPhaseRunning, msg); err != nil {
+			return err
+		}
+		rollout = postgres.Status.Rollout
+	} else if rollout.Phase != api.RolloutPhaseRunning {
+		// a failed rollout is not continued, until the StatefulSet has a new revision
+		return nil
+	}
+
+	// leader election may not be defaulted, if the mutating webhook is disabled
+	spec := postgres.Spec.DeepCopy()
+	spec.SetDefaults()
+	members, primary := memberStatuses(postgres, spec.LeaderElection, pods, metav1.Now())
+
+	// members are restarted one at a time, the last restarted member must be back before the next one
+	var waiting string
+	if len(pods) < int(types.Int32(statefulSet.Spec.Replicas)) {
+		waiting = "missing members to be created"
+	}
+	for i, m := range members {
+		if pods[i].DeletionTimestamp != nil {
+			waiting = fmt.Sprintf("%s to terminate", m.Name)
+		} else if !outdated[m.Name] && !isStreaming(m, spec.LeaderElection) {
+			waiting = fmt.Sprintf("%s to become ready and catch up with the primary", m.Name)
+		}
+	}
+	if sw := postgres.Status.Switchover; sw != nil && (sw.Phase == api.SwitchoverPhasePending || sw.Phase == api.SwitchoverPhaseRunning) {
+		waiting = fmt.Sprintf("switchover to %s to complete", sw.Target)
+	}
+	if waiting != "" {
+		if time.Since(rollout.LastTransitionTime.Time) > rolloutMemberTimeout {
+			msg := fmt.Sprintf("rolling restart is halted, after waiting %v for %s", rolloutMemberTimeout, waiting)
+			c.recorder.Event(postgres, core.EventTypeWarning, EventReasonRollingRestartFailed, msg)
+			return c.updateRollout(postgres, revision, rollout.Member, api.RolloutPhaseFailed, msg)
+		}
+		log.Debugf("Rolling restart of Postgres %s/%s is waiting for %s", postgres.Namespace, postgres.Name, waiting)
+		return nil
+	}
+
+	next := nextRolloutMember(members, outdated)
+	if next.Role != le.RolePrimary || len(members) == 1 {
+		if err := c.Client.CoreV1().Pods(postgres.Namespace).Delete(next.Name, &metav1.DeleteOptions{}); err != nil && !kerr.IsNotFound(err) {
+			return err
+		}
+		msg := fmt.Sprintf("restarting %s with revision %s", next.Name, revision)
+		c.recorder.Event(postgres, core.EventTypeNormal, EventReasonRollingRestart, msg)
+		return c.updateRollout(postgres, revision, next.Name, api.RolloutPhaseRunning, msg)
+	}
+
+	// the primary is restarted as replica, after the leadership has been handed over to the best replica
+	if sw := postgres.Status.Switchover; rollout.Member == next.Name && sw != nil && sw.Phase == api.SwitchoverPhaseFailed &&
+		!sw.LastTransitionTime.Before(&rollout.LastTransitionTime) {
+		msg := fmt.Sprintf("rolling restart is halted, as switchover from primary %s failed. Reason: %s", next.Name, sw.Reason)
+		c.recorder.Event(postgres, core.EventTypeWarning, EventReasonRollingRestartFailed, msg)
+		return c.updateRollout(postgres, revision, next.Name, api.RolloutPhaseFailed, msg)
+	}
+	if primary == nil || primary.count > 1 {
+		return nil
+	}
+	if err := c.requestSwitchover(postgres, api.SwitchoverBestReplica); err != nil {
+		return err
+	}
+	return c.updateRollout(postgres, revision, next.Name, api.RolloutPhaseRunning,
+		fmt.Sprintf("switching primary %s over to a replica, before restarting it", next.Name))
+}
+
+// nextRolloutMember returns the outdated member to restart next. Replicas that are not ready are restarted
+// first, as they do not serve anyway, then the other replicas by descending ordinal, and the primary last.
+func nextRolloutMember(members []api.PostgresMemberStatus, outdated map[string]bool) api.PostgresMemberStatus {
+	var candidates []api.PostgresMemberStatus
+	for _, m := range members {
+		if outdated[m.Name] {
+			candidates = append(candidates, m)
+		}
+	}
+	rank := func(m api.PostgresMemberStatus) int {
+		switch {
+		case m.Role == le.RolePrimary:
+			return 2
+		case m.Ready:
+			return 1
+		}
+		return 0
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if rank(candidates[i]) != rank(candidates[j]) {
+			return rank(candidates[i]) < rank(candidates[j])
+		}
+		return ordinal(candidates[i].Name) > ordinal(candidates[j].Name)
+	})
+	return candidates[0]
+}
+
+// isStreaming returns true, if m is ready, and caught up with the primary unless it is the primary itself.
+func isStreaming(m api.PostgresMemberStatus, election *api.LeaderElectionConfig) bool {
+	if !m.Ready {
+		return false
+	}
+	if m.Role == le.RolePrimary {
+		return true
+	}
+	return m.LagBytes != nil && *m.LagBytes <= maximumLag(election)
+}
+
+// maximumLag returns the lag in bytes of WAL, up to which a replica is caught up with the primary.
+func maximumLag(election *api.LeaderElectionConfig) int64 {
+	if election == nil || election.MaximumLagBeforeFailover == nil {
+		return api.DefaultMaximumLagBeforeFailover
+	}
+	return *election.MaximumLagBeforeFailover
+}
+
+// ordinal returns the ordinal of the StatefulSet pod name, or -1 if it has none.
+func ordinal(name string) int {
+	n, err := strconv.Atoi(name[strings.LastIndex(name, "-")+1:])
+	if err != nil {
+		return -1
+	}
+	return n
+}
+
+func (c *Controller) updateRollout(postgres *api.Postgres, revision, member string, phase api.RolloutPhase, reason string) error {
+	pg, err := util.UpdatePostgresStatus(c.ExtClient.KubedbV1alpha1(), postgres, func(in *api.PostgresStatus) *api.PostgresStatus {
+		in.Rollout = &api.PostgresRolloutStatus{
+			Revision:           revision,
+			Member:             member,
+			Phase:              phase,
+			Reason:             reason,
+			LastTransitionTime: metav1.Now(),
+		}
+		return in
+	})
+	if err != nil {
+		return err
+	}
+	postgres.Status = pg.Status
+	return nil
+}
+
+// syncRollouts processes the Postgres objects again, whose members run an outdated revision of their StatefulSet.
+// The rollout waits for pods and sidecars, that do not trigger the processing of their Postgres.
+func (c *Controller) syncRollouts() {
+	dbs, err := c.pgLister.List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	for _, postgres := range dbs {
+		if postgres.DeletionTimestamp != nil || postgres.Status.Phase != api.DatabasePhaseRunning ||
+			postgres.Spec.UpdateStrategy.Type == apps.OnDeleteStatefulSetStrategyType ||
+			(postgres.Status.Upgrade != nil && upgradeInProgress(postgres.Status.Upgrade.Phase)) {
+			continue
+		}
+		if rollout := postgres.Status.Rollout; rollout != nil && rollout.Phase == api.RolloutPhaseRunning {
+			queue.Enqueue(c.pgQueue.GetQueue(), postgres)
+			continue
+		}
+		outdated, err := c.hasOutdatedMembers(postgres)
+		if err != nil {
+			log.Errorf("failed to check revision of Postgres %s/%s members. Reason: %v", postgres.Namespace, postgres.Name, err)
+		} else if outdated {
+			queue.Enqueue(c.pgQueue.GetQueue(), postgres)
+		}
+	}
+}
+
+// hasOutdatedMembers returns true, if a member of postgres does not run the update revision of its StatefulSet,
+// and the rollout of that revision has not failed.
+func (c *Controller) hasOutdatedMembers(postgres *api.Postgres) (bool, error) {
+	statefulSet, err := c.Client.AppsV1().StatefulSets(postgres.Namespace).Get(postgres.OffshootName(), metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	revision := statefulSet.Status.UpdateRevision
+	if revision == "" || statefulSet.Status.ObservedGeneration < statefulSet.Generation {
+		return false, nil
+	}
+	if rollout := postgres.Status.Rollout; rollout != nil && rollout.Revision == revision && rollout.Phase == api.RolloutPhaseFailed {
+		return false, nil
+	}
+	return statefulSet.Status.UpdatedReplicas < statefulSet.Status.Replicas, nil
+}
@@ -0,0 +1,184 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"testing"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	le "kubedb.dev/postgres/pkg/leader_election"
+
+	"github.com/appscode/go/types"
+	apps "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestOrdinal(t *testing.T) {
+	cases := []struct {
+		name string
+		want int
+	}{
+		{"foo-0", 0},
+		{"foo-12", 12},
+		{"foo-bar-2", 2},
+		{"foo", -1},
+		{"foo-", -1},
+		{"foo-bar", -1},
+		{"", -1},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := ordinal(c.name); got != c.want {
+				t.Errorf("ordinal(%q) = %d, want %d", c.name, got, c.want)
+			}
+		})
+	}
+}
+
+func TestIsStreaming(t *testing.T) {
+	election := &api.LeaderElectionConfig{MaximumLagBeforeFailover: types.Int64P(100)}
+	lag := func(bytes int64) *int64 {
+		return &bytes
+	}
+	cases := []struct {
+		name   string
+		member api.PostgresMemberStatus
+		want   bool
+	}{
+		{"ready primary", api.PostgresMemberStatus{Role: le.RolePrimary, Ready: true}, true},
+		{"primary not ready", api.PostgresMemberStatus{Role: le.RolePrimary}, false},
+		{"replica caught up", api.PostgresMemberStatus{Role: le.RoleReplica, Ready: true, LagBytes: lag(100)}, true},
+		{"replica lagging", api.PostgresMemberStatus{Role: le.RoleReplica, Ready: true, LagBytes: lag(101)}, false},
+		{"replica with unknown lag", api.PostgresMemberStatus{Role: le.RoleReplica, Ready: true}, false},
+		{"replica not ready", api.PostgresMemberStatus{Role: le.RoleReplica, LagBytes: lag(0)}, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := isStreaming(c.member, election); got != c.want {
+				t.Errorf("isStreaming() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestMaximumLag(t *testing.T) {
+	cases := []struct {
+		name     string
+		election *api.LeaderElectionConfig
+		want     int64
+	}{
+		{"without leader election", nil, api.DefaultMaximumLagBeforeFailover},
+		{"unset", &api.LeaderElectionConfig{}, api.DefaultMaximumLagBeforeFailover},
+		{"strict", &api.LeaderElectionConfig{MaximumLagBeforeFailover: types.Int64P(0)}, 0},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := maximumLag(c.election); got != c.want {
+				t.Errorf("maximumLag() = %d, want %d", got, c.want)
+			}
+		})
+	}
+}
+
+func TestNextRolloutMember(t *testing.T) {
+	member := func(name, role string, ready bool) api.PostgresMemberStatus {
+		return api.PostgresMemberStatus{Name: name, Role: role, Ready: ready}
+	}
+	members := []api.PostgresMemberStatus{
+		member("foo-0", le.RolePrimary, true),
+		member("foo-1", le.RoleReplica, true),
+		member("foo-2", le.RoleReplica, true),
+		member("foo-3", le.RoleReplica, false),
+	}
+	cases := []struct {
+		name     string
+		outdated []string
+		want     string
+	}{
+		{"replica that is not ready first", []string{"foo-0", "foo-1", "foo-2", "foo-3"}, "foo-3"},
+		{"highest ordinal among ready replicas", []string{"foo-0", "foo-1", "foo-2"}, "foo-2"},
+		{"only outdated members", []string{"foo-0", "foo-1"}, "foo-1"},
+		{"primary last", []string{"foo-0"}, "foo-0"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			outdated := map[string]bool{}
+			for _, name := range c.outdated {
+				outdated[name] = true
+			}
+			if got := nextRolloutMember(members, outdated); got.Name != c.want {
+				t.Errorf("nextRolloutMember() = %s, want %s", got.Name, c.want)
+			}
+		})
+	}
+
+	// a member without ordinal sorts after the members with one
+	got := nextRolloutMember([]api.PostgresMemberStatus{member("foo", le.RoleReplica, true), member("foo-1", le.RoleReplica, true)},
+		map[string]bool{"foo": true, "foo-1": true})
+	if got.Name != "foo-1" {
+		t.Errorf("nextRolloutMember() = %s, want foo-1", got.Name)
+	}
+}
+
+func TestHasOutdatedMembers(t *testing.T) {
+	statefulSet := func(generation, observed int64, replicas, updated int32, revision string) *apps.StatefulSet {
+		return &apps.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default", Generation: generation},
+			Status: apps.StatefulSetStatus{
+				ObservedGeneration: observed,
+				Replicas:           replicas,
+				UpdatedReplicas:    updated,
+				UpdateRevision:     revision,
+			},
+		}
+	}
+	failed := &api.PostgresRolloutStatus{Revision: "rev-2", Phase: api.RolloutPhaseFailed}
+
+	cases := []struct {
+		name        string
+		statefulSet *apps.StatefulSet
+		rollout     *api.PostgresRolloutStatus
+		want        bool
+	}{
+		{"no StatefulSet", nil, nil, false},
+		{"all members updated", statefulSet(2, 2, 3, 3, "rev-2"), nil, false},
+		{"outdated members", statefulSet(2, 2, 3, 1, "rev-2"), nil, true},
+		{"generation not observed", statefulSet(3, 2, 3, 1, "rev-2"), nil, false},
+		{"no update revision", statefulSet(2, 2, 3, 1, ""), nil, false},
+		{"rollout of revision failed", statefulSet(2, 2, 3, 1, "rev-2"), failed, false},
+		{"rollout of older revision failed", statefulSet(3, 3, 3, 1, "rev-3"), failed, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			postgres := &api.Postgres{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+				Status:     api.PostgresStatus{Rollout: c.rollout},
+			}
+			var kubeObjects []runtime.Object
+			if c.statefulSet != nil {
+				kubeObjects = append(kubeObjects, c.statefulSet)
+			}
+			got, err := newTestController(kubeObjects).hasOutdatedMembers(postgres)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != c.want {
+				t.Errorf("hasOutdatedMembers() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
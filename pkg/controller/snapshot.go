@@ -20,6 +20,7 @@ import (
 
 	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
 	amv "kubedb.dev/apimachinery/pkg/validator"
+	validator "kubedb.dev/postgres/pkg/admission"
 )
 
 func (c *Controller) ValidateSnapshot(snapshot *api.Snapshot) error {
@@ -33,6 +34,12 @@ func (c *Controller) ValidateSnapshot(snapshot *api.Snapshot) error {
 		return err
 	}
 
+	if encryption := snapshot.Spec.Encryption; encryption != nil {
+		if err := validator.ValidateEncryptionSecret(c.Client, snapshot.Namespace, "spec.encryption", encryption, true); err != nil {
+			return err
+		}
+	}
+
 	return amv.ValidateSnapshotSpec(snapshot.Spec.Backend)
 }
 
@@ -0,0 +1,80 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strings"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	le "kubedb.dev/postgres/pkg/leader_election"
+
+	_ "github.com/lib/pq"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// openPostgres returns a handle to database on the primary of postgres, authenticated as the superuser of the
+// database secret. The connection is encrypted, if TLS is enabled for postgres.
+func (c *Controller) openPostgres(postgres *api.Postgres, database string) (*sql.DB, error) {
+	if err := superuserRestricted(postgres); err != nil {
+		return nil, err
+	}
+	if postgres.Spec.DatabaseSecret == nil {
+		return nil, fmt.Errorf("database secret of Postgres %s/%s is not created yet", postgres.Namespace, postgres.Name)
+	}
+	secret, err := c.Client.CoreV1().Secrets(postgres.Namespace).Get(postgres.Spec.DatabaseSecret.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	password := secret.Data[PostgresPassword]
+	// the primary has set the rotated password already, that replaces the password in the secret soon
+	if rotation := postgres.Status.PasswordRotation; rotation != nil && rotation.Phase == api.PasswordRotationPhaseApplied &&
+		len(secret.Data[le.PasswordNextKey]) > 0 {
+		password = secret.Data[le.PasswordNextKey]
+	}
+
+	sslMode := "disable"
+	if postgres.Spec.TLS != nil {
+		sslMode = "require"
+	}
+	cnnstr := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(passwordUser(secret), string(password)),
+		Host:     fmt.Sprintf("%s.%s.svc:%d", postgres.ServiceName(), postgres.Namespace, PostgresPort),
+		Path:     database,
+		RawQuery: "sslmode=" + sslMode + "&connect_timeout=5",
+	}
+	db, err := sql.Open("postgres", cnnstr.String())
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// quoteLiteral quotes s to be used as string constant in SQL statements.
+func quoteLiteral(s string) string {
+	s = strings.Replace(s, `'`, `''`, -1)
+	if strings.Contains(s, `\`) {
+		return `E'` + strings.Replace(s, `\`, `\\`, -1) + `'`
+	}
+	return `'` + s + `'`
+}
@@ -0,0 +1,42 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import "testing"
+
+func TestQuoteLiteral(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain", "UTF8", `'UTF8'`},
+		{"empty", "", `''`},
+		{"single quote", "it's", `'it''s'`},
+		{"injection", "x'; DROP TABLE users; --", `'x''; DROP TABLE users; --'`},
+		{"backslash", `C:\data`, `E'C:\\data'`},
+		{"backslash before quote", `\'`, `E'\\'''`},
+		{"trailing backslash", `x\`, `E'x\\'`},
+		{"double quote", `"x"`, `'"x"'`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := quoteLiteral(c.in); got != c.want {
+				t.Errorf("quoteLiteral(%q) = %s, want %s", c.in, got, c.want)
+			}
+		})
+	}
+}
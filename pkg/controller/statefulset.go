@@ -32,6 +32,7 @@ import (
 	core "k8s.io/api/core/v1"
 	kerr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	clientsetscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/reference"
 	kutil "kmodules.xyz/client-go"
@@ -67,9 +68,15 @@ func (c *Controller) ensureStatefulSet(
 		replicas = types.Int32(postgres.Spec.Replicas)
 	}
 
+	tlsSerial, err := c.serverCertSerial(postgres)
+	if err != nil {
+		return kutil.VerbUnchanged, err
+	}
+
 	statefulSet, vt, err := app_util.CreateOrPatchStatefulSet(c.Client, statefulSetMeta, func(in *apps.StatefulSet) *apps.StatefulSet {
 		in.Labels = postgres.OffshootLabels()
-		in.Annotations = postgres.Spec.PodTemplate.Controller.Annotations
+		in.Annotations = core_util.UpsertMap(map[string]string{}, postgres.Spec.PodTemplate.Controller.Annotations)
+		in.Annotations[AnnotationPostgresVersion] = postgresVersion.Name
 		core_util.EnsureOwnerReference(&in.ObjectMeta, ref)
 
 		in.Spec.Replicas = types.Int32P(replicas)
@@ -79,7 +86,23 @@ func (c *Controller) ensureStatefulSet(
 			MatchLabels: postgres.OffshootSelectors(),
 		}
 		in.Spec.Template.Labels = postgres.OffshootSelectors()
-		in.Spec.Template.Annotations = postgres.Spec.PodTemplate.Annotations
+		in.Spec.Template.Annotations = core_util.UpsertMap(map[string]string{}, postgres.Spec.PodTemplate.Annotations)
+		if restart, found := postgres.Annotations[api.AnnotationRestart]; found {
+			// a new value results in a new revision, that the members are restarted with
+			in.Spec.Template.Annotations[api.AnnotationRestart] = restart
+		}
+		if tlsSerial != "" {
+			// renewed certificates are picked up by restarting the members
+			in.Spec.Template.Annotations[AnnotationTLSSerial] = tlsSerial
+		}
+		if rotated := passwordRotated(postgres); rotated != "" {
+			// the members and the exporter read the rotated password from the database secret, once restarted
+			in.Spec.Template.Annotations[AnnotationPasswordRotated] = rotated
+		}
+		if restart := restartParameters(postgres, postgresVersion); restart != "" {
+			// parameters that are applied at server start only, are applied by restarting the members
+			in.Spec.Template.Annotations[AnnotationRestartParameters] = restart
+		}
 		in.Spec.Template.Spec.InitContainers = core_util.UpsertContainers(in.Spec.Template.Spec.InitContainers, postgres.Spec.PodTemplate.Spec.InitContainers)
 		in.Spec.Template.Spec.Containers = core_util.UpsertContainer(
 			in.Spec.Template.Spec.Containers,
@@ -97,8 +120,8 @@ func (c *Controller) ensureStatefulSet(
 				},
 				Image:          postgresVersion.Spec.DB.Image,
 				Resources:      postgres.Spec.PodTemplate.Spec.Resources,
-				LivenessProbe:  postgres.Spec.PodTemplate.Spec.LivenessProbe,
-				ReadinessProbe: postgres.Spec.PodTemplate.Spec.ReadinessProbe,
+				LivenessProbe:  livenessProbe(postgres),
+				ReadinessProbe: readinessProbe(postgres),
 				Lifecycle:      postgres.Spec.PodTemplate.Spec.Lifecycle,
 				SecurityContext: &core.SecurityContext{
 					Privileged: types.BoolP(false),
@@ -130,6 +153,9 @@ func (c *Controller) ensureStatefulSet(
 				if archiverStorage.Local == nil {
 					in = upsertArchiveSecret(in, archiverStorage.StorageSecretName)
 				}
+				if encryption := postgres.Spec.Archiver.Encryption; encryption != nil {
+					in = upsertEncryptionSecret(in, "wal-g-archive-encryption", "/srv/wal-g/archive/encryption", encryption.SecretName)
+				}
 			}
 		}
 
@@ -139,6 +165,12 @@ func (c *Controller) ensureStatefulSet(
 				//Getting secret for cloud providers
 				in = upsertInitWalSecret(in, postgres.Spec.Init.PostgresWAL.StorageSecretName)
 			}
+			if initSource != nil && initSource.PostgresWAL != nil && initSource.PostgresWAL.Encryption != nil {
+				in = upsertEncryptionSecret(in, "wal-g-restore-encryption", "/srv/wal-g/restore/encryption", initSource.PostgresWAL.Encryption.SecretName)
+			}
+			if initSource != nil && initSource.PostgresClone != nil {
+				in = upsertCloneSecret(in, postgres)
+			}
 			if initSource != nil && initSource.ScriptSource != nil {
 				in = upsertInitScript(in, postgres.Spec.Init.ScriptSource.VolumeSource)
 			}
@@ -147,11 +179,17 @@ func (c *Controller) ensureStatefulSet(
 		in = upsertShm(in)
 		in = upsertDataVolume(in, postgres)
 		in = upsertCustomConfig(in, postgres)
+		in = upsertParameters(in, postgres)
+		in = upsertClientAuthentication(in, postgres)
+		in = upsertTLS(in, postgres)
 
 		if c.EnableRBAC {
 			in.Spec.Template.Spec.ServiceAccountName = postgres.Spec.PodTemplate.Spec.ServiceAccountName
 		}
-		in.Spec.UpdateStrategy = postgres.Spec.UpdateStrategy
+		// the members are restarted by the operator, see ensureRollout
+		in.Spec.UpdateStrategy = apps.StatefulSetUpdateStrategy{
+			Type: apps.OnDeleteStatefulSetStrategyType,
+		}
 
 		return in
 	})
@@ -217,6 +255,28 @@ func (c *Controller) ensureCombinedNode(postgres *api.Postgres, postgresVersion
 		},
 	}
 
+	if sr := postgres.Spec.SynchronousReplication; streamingMode == api.SynchronousPostgresStreamingMode && sr != nil {
+		envList = append(envList, []core.EnvVar{
+			{
+				Name:  leader_election.SynchronousStandbysEnv,
+				Value: strconv.Itoa(int(sr.SynchronousStandbys)),
+			},
+			{
+				Name:  leader_election.SynchronousReplicationPolicyEnv,
+				Value: string(sr.Policy),
+			},
+		}...)
+	}
+
+	lockType, err := c.leaderLockType(postgres)
+	if err != nil {
+		return kutil.VerbUnchanged, err
+	}
+	envList = append(envList, core.EnvVar{
+		Name:  leader_election.LockTypeEnv,
+		Value: lockType,
+	})
+
 	if postgres.Spec.LeaderElection != nil {
 		envList = append(envList, []core.EnvVar{
 			{
@@ -231,6 +291,22 @@ func (c *Controller) ensureCombinedNode(postgres *api.Postgres, postgresVersion
 				Name:  leader_election.RetryPeriodEnv,
 				Value: strconv.Itoa(int(postgres.Spec.LeaderElection.RetryPeriodSeconds)),
 			},
+			{
+				Name:  leader_election.MaximumLagBeforeFailoverEnv,
+				Value: strconv.FormatInt(maximumLag(postgres.Spec.LeaderElection), 10),
+			},
+			{
+				Name:  leader_election.FencingTimeoutEnv,
+				Value: strconv.Itoa(int(postgres.Spec.LeaderElection.FencingTimeoutSeconds)),
+			},
+			{
+				Name:  leader_election.HealthCheckPeriodEnv,
+				Value: strconv.Itoa(int(postgres.Spec.LeaderElection.HealthCheckPeriodSeconds)),
+			},
+			{
+				Name:  leader_election.HealthCheckFailureThresholdEnv,
+				Value: strconv.Itoa(int(postgres.Spec.LeaderElection.HealthCheckFailureThreshold)),
+			},
 		}...)
 	}
 
@@ -296,14 +372,36 @@ func (c *Controller) ensureCombinedNode(postgres *api.Postgres, postgresVersion
 					},
 				)
 			}
+			if encryption := postgres.Spec.Archiver.Encryption; encryption != nil {
+				envList = append(envList,
+					core.EnvVar{
+						Name:  "ARCHIVE_ENCRYPTION_KEY_ID",
+						Value: encryption.KeyID,
+					},
+				)
+			}
 		}
 	}
 
+	if tls := postgres.Spec.TLS; tls != nil {
+		sslMode := leader_election.SSLModeOn
+		if tls.RequireSSL {
+			sslMode = leader_election.SSLModeRequired
+		}
+		envList = append(envList, core.EnvVar{
+			Name:  leader_election.SSLModeEnv,
+			Value: sslMode,
+		})
+	}
+
 	if postgres.Spec.Init != nil {
 		wal := postgres.Spec.Init.PostgresWAL
 		if wal != nil {
 			envList = append(envList, walRecoveryConfig(wal)...)
 		}
+		if postgres.Spec.Init.PostgresClone != nil {
+			envList = append(envList, cloneConfig(postgres)...)
+		}
 	}
 
 	return c.ensureStatefulSet(postgres, postgresVersion, envList)
@@ -380,6 +478,40 @@ func upsertEnv(statefulSet *apps.StatefulSet, postgres *api.Postgres, envs []cor
 	return statefulSet
 }
 
+// livenessProbe returns the user provided liveness probe, or a probe of the health checks
+// done by the leader election sidecar.
+func livenessProbe(postgres *api.Postgres) *core.Probe {
+	if postgres.Spec.PodTemplate.Spec.LivenessProbe != nil {
+		return postgres.Spec.PodTemplate.Spec.LivenessProbe
+	}
+	return healthCheckProbe(postgres, leader_election.LivenessPath)
+}
+
+// readinessProbe returns the user provided readiness probe, or a probe of the health checks
+// done by the leader election sidecar.
+func readinessProbe(postgres *api.Postgres) *core.Probe {
+	if postgres.Spec.PodTemplate.Spec.ReadinessProbe != nil {
+		return postgres.Spec.PodTemplate.Spec.ReadinessProbe
+	}
+	return healthCheckProbe(postgres, leader_election.ReadinessPath)
+}
+
+func healthCheckProbe(postgres *api.Postgres, path string) *core.Probe {
+	periodSeconds := int32(api.DefaultHealthCheckPeriodSeconds)
+	if postgres.Spec.LeaderElection != nil {
+		periodSeconds = postgres.Spec.LeaderElection.HealthCheckPeriodSeconds
+	}
+	return &core.Probe{
+		Handler: core.Handler{
+			HTTPGet: &core.HTTPGetAction{
+				Path: path,
+				Port: intstr.FromInt(leader_election.HealthServerPort),
+			},
+		},
+		PeriodSeconds: periodSeconds,
+	}
+}
+
 // upsertUserEnv add/overwrite env from user provided env in crd spec
 func upsertUserEnv(statefulSet *apps.StatefulSet, postgress *api.Postgres) *apps.StatefulSet {
 	for i, container := range statefulSet.Spec.Template.Spec.Containers {
@@ -434,10 +566,30 @@ func (c *Controller) upsertMonitoringContainer(statefulSet *apps.StatefulSet, po
 			SecurityContext: postgres.Spec.Monitor.SecurityContext,
 		}
 
+		dataSource := fmt.Sprintf("localhost:%d/?sslmode=disable", PostgresPort)
+		if postgres.Spec.TLS != nil {
+			dataSource = fmt.Sprintf("localhost:%d/?sslmode=verify-full&sslcert=%s&sslkey=%s&sslrootcert=%s", PostgresPort,
+				path.Join(exporterCertDir, core.TLSCertKey), path.Join(exporterCertDir, core.TLSPrivateKeyKey), path.Join(exporterCertDir, leader_election.TLSCA))
+			container.VolumeMounts = core_util.UpsertVolumeMount(container.VolumeMounts, core.VolumeMount{
+				Name:      "exporter-cert",
+				MountPath: exporterCertDir,
+				ReadOnly:  true,
+			})
+			statefulSet.Spec.Template.Spec.Volumes = core_util.UpsertVolume(statefulSet.Spec.Template.Spec.Volumes, core.Volume{
+				Name: "exporter-cert",
+				VolumeSource: core.VolumeSource{
+					Secret: &core.SecretVolumeSource{
+						SecretName:  exporterCertSecretName(postgres),
+						DefaultMode: types.Int32P(0400),
+					},
+				},
+			})
+		}
+
 		envList := []core.EnvVar{
 			{
 				Name:  "DATA_SOURCE_URI",
-				Value: fmt.Sprintf("localhost:%d/?sslmode=disable", PostgresPort),
+				Value: dataSource,
 			},
 			{
 				Name: "DATA_SOURCE_USER",
@@ -507,6 +659,30 @@ func upsertArchiveSecret(statefulSet *apps.StatefulSet, secretName string) *apps
 	return statefulSet
 }
 
+// upsertEncryptionSecret mounts the encryption keys of an archive at mountPath, that wal-g encrypts or decrypts the
+// archive with.
+func upsertEncryptionSecret(statefulSet *apps.StatefulSet, volumeName, mountPath, secretName string) *apps.StatefulSet {
+	for i, container := range statefulSet.Spec.Template.Spec.Containers {
+		if container.Name == api.ResourceSingularPostgres {
+			statefulSet.Spec.Template.Spec.Containers[i].VolumeMounts = core_util.UpsertVolumeMount(container.VolumeMounts, core.VolumeMount{
+				Name:      volumeName,
+				MountPath: mountPath,
+				ReadOnly:  true,
+			})
+			statefulSet.Spec.Template.Spec.Volumes = core_util.UpsertVolume(statefulSet.Spec.Template.Spec.Volumes, core.Volume{
+				Name: volumeName,
+				VolumeSource: core.VolumeSource{
+					Secret: &core.SecretVolumeSource{
+						SecretName: secretName,
+					},
+				},
+			})
+			return statefulSet
+		}
+	}
+	return statefulSet
+}
+
 func upsertInitWalSecret(statefulSet *apps.StatefulSet, secretName string) *apps.StatefulSet {
 	for i, container := range statefulSet.Spec.Template.Spec.Containers {
 		if container.Name == api.ResourceSingularPostgres {
@@ -535,6 +711,69 @@ func upsertInitWalSecret(statefulSet *apps.StatefulSet, secretName string) *apps
 	return statefulSet
 }
 
+// upsertTLS mounts the server certificate and the replication client certificate of postgres, if TLS is enabled.
+// The sidecar prepares them for postgres, see leader_election.ServerCertDir and leader_election.ClientCertDir.
+// Otherwise, the certificates mounted before TLS has been disabled are removed.
+func upsertTLS(statefulSet *apps.StatefulSet, postgres *api.Postgres) *apps.StatefulSet {
+	podSpec := &statefulSet.Spec.Template.Spec
+	if postgres.Spec.TLS == nil {
+		for i, container := range podSpec.Containers {
+			podSpec.Containers[i].Env = core_util.EnsureEnvVarDeleted(container.Env, leader_election.SSLModeEnv)
+			for _, name := range []string{"server-cert", "replication-cert", "exporter-cert"} {
+				podSpec.Containers[i].VolumeMounts = core_util.EnsureVolumeMountDeleted(podSpec.Containers[i].VolumeMounts, name)
+			}
+		}
+		for _, name := range []string{"server-cert", "replication-cert", "exporter-cert"} {
+			podSpec.Volumes = core_util.EnsureVolumeDeleted(podSpec.Volumes, name)
+		}
+		return statefulSet
+	}
+
+	for i, container := range podSpec.Containers {
+		if container.Name == api.ResourceSingularPostgres {
+			volumeMounts := container.VolumeMounts
+			volumeMounts = core_util.UpsertVolumeMount(volumeMounts,
+				core.VolumeMount{
+					Name:      "server-cert",
+					MountPath: leader_election.ServerCertDir,
+					ReadOnly:  true,
+				},
+				core.VolumeMount{
+					Name:      "replication-cert",
+					MountPath: leader_election.ClientCertDir,
+					ReadOnly:  true,
+				},
+			)
+			podSpec.Containers[i].VolumeMounts = volumeMounts
+
+			volumes := podSpec.Volumes
+			volumes = core_util.UpsertVolume(volumes,
+				core.Volume{
+					Name: "server-cert",
+					VolumeSource: core.VolumeSource{
+						Secret: &core.SecretVolumeSource{
+							SecretName:  serverCertSecretName(postgres),
+							DefaultMode: types.Int32P(0400),
+						},
+					},
+				},
+				core.Volume{
+					Name: "replication-cert",
+					VolumeSource: core.VolumeSource{
+						Secret: &core.SecretVolumeSource{
+							SecretName:  replicationCertSecretName(postgres),
+							DefaultMode: types.Int32P(0400),
+						},
+					},
+				},
+			)
+			podSpec.Volumes = volumes
+			return statefulSet
+		}
+	}
+	return statefulSet
+}
+
 func upsertShm(statefulSet *apps.StatefulSet) *apps.StatefulSet {
 	for i, container := range statefulSet.Spec.Template.Spec.Containers {
 		if container.Name == api.ResourceSingularPostgres {
@@ -677,6 +916,13 @@ func upsertDataVolume(statefulSet *apps.StatefulSet, postgres *api.Postgres) *ap
 						"volume.beta.kubernetes.io/storage-class": *pvcSpec.StorageClassName,
 					}
 				}
+				for _, vc := range statefulSet.Spec.VolumeClaimTemplates {
+					if vc.Name == claim.Name {
+						// volume claim templates are immutable, an increased storage request is applied
+						// by recreating the StatefulSet after the volumes are expanded, see ensureVolumeExpansion
+						claim.Spec.Resources = *vc.Spec.Resources.DeepCopy()
+					}
+				}
 				statefulSet.Spec.VolumeClaimTemplates = core_util.UpsertVolumeClaim(statefulSet.Spec.VolumeClaimTemplates, claim)
 			}
 			break
@@ -774,6 +1020,15 @@ func walRecoveryConfig(wal *api.PostgresWALSourceSpec) []core.EnvVar {
 		)
 	}
 
+	if wal.Encryption != nil {
+		envList = append(envList,
+			core.EnvVar{
+				Name:  "RESTORE_ENCRYPTION",
+				Value: "true",
+			},
+		)
+	}
+
 	if wal.PITR != nil {
 		envList = append(envList,
 			[]core.EnvVar{
@@ -813,6 +1068,24 @@ func walRecoveryConfig(wal *api.PostgresWALSourceSpec) []core.EnvVar {
 					},
 				}...)
 		}
+		if wal.PITR.TargetLSN != "" {
+			envList = append(envList,
+				[]core.EnvVar{
+					{
+						Name:  "TARGET_LSN",
+						Value: wal.PITR.TargetLSN,
+					},
+				}...)
+		}
+		if wal.PITR.TargetName != "" {
+			envList = append(envList,
+				[]core.EnvVar{
+					{
+						Name:  "TARGET_NAME",
+						Value: wal.PITR.TargetName,
+					},
+				}...)
+		}
 	}
 	return envList
 }
@@ -0,0 +1,158 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	"kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1/util"
+	le "kubedb.dev/postgres/pkg/leader_election"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// ensureSwitchover accepts a planned switchover requested through the switchover annotation of postgres.
+// The leader election sidecar of the current primary carries it out, once it is recorded as Pending in status.
+// The annotation is removed after the request has been accepted or rejected.
+func (c *Controller) ensureSwitchover(postgres *api.Postgres) error {
+	if err := c.expireSwitchover(postgres, time.Now()); err != nil {
+		return err
+	}
+
+	target, found := postgres.Annotations[api.AnnotationSwitchover]
+	if !found {
+		return nil
+	}
+
+	if err := c.requestSwitchover(postgres, target); err != nil {
+		return err
+	}
+
+	pg, _, err := util.PatchPostgres(c.ExtClient.KubedbV1alpha1(), postgres, func(in *api.Postgres) *api.Postgres {
+		delete(in.Annotations, api.AnnotationSwitchover)
+		return in
+	})
+	if err != nil {
+		return err
+	}
+	postgres.ObjectMeta = pg.ObjectMeta
+	return nil
+}
+
+// requestSwitchover records a switchover to target as Pending in the status of postgres, if it can be started.
+// A rejected switchover is only recorded as event.
+func (c *Controller) requestSwitchover(postgres *api.Postgres, target string) error {
+	primary, err := c.switchoverPrimary(postgres, target)
+	if err != nil {
+		c.recorder.Event(postgres, core.EventTypeWarning, le.EventReasonSwitchoverFailed, err.Error())
+		return nil
+	}
+	pg, err := util.UpdatePostgresStatus(c.ExtClient.KubedbV1alpha1(), postgres, func(in *api.PostgresStatus) *api.PostgresStatus {
+		in.Switchover = &api.PostgresSwitchoverStatus{
+			Target:             target,
+			Primary:            primary,
+			Phase:              api.SwitchoverPhasePending,
+			Reason:             fmt.Sprintf("switchover from %s to %s is requested", primary, target),
+			LastTransitionTime: metav1.Now(),
+		}
+		return in
+	})
+	if err != nil {
+		return err
+	}
+	postgres.Status = pg.Status
+	c.recorder.Eventf(postgres, core.EventTypeNormal, le.EventReasonSwitchoverStarted, "switchover from %s to %s is requested", primary, target)
+	return nil
+}
+
+// switchoverPrimary returns the current primary of postgres, if a switchover to target can be started.
+func (c *Controller) switchoverPrimary(postgres *api.Postgres, target string) (string, error) {
+	if sw := postgres.Status.Switchover; sw != nil &&
+		(sw.Phase == api.SwitchoverPhasePending || sw.Phase == api.SwitchoverPhaseRunning) {
+		return "", fmt.Errorf("switchover to %s is rejected, as switchover to %s is in progress", target, sw.Target)
+	}
+
+	pods, err := c.Client.CoreV1().Pods(postgres.Namespace).List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(postgres.OffshootSelectors()).String(),
+	})
+	if err != nil {
+		return "", err
+	}
+	primary, targetFound := "", target == api.SwitchoverBestReplica
+	for _, pod := range pods.Items {
+		switch {
+		case pod.Labels[NodeRole] == le.RolePrimary:
+			primary = pod.Name
+		case pod.Name == target:
+			targetFound = true
+		}
+	}
+	if primary == "" {
+		return "", fmt.Errorf("switchover to %s is rejected, as there is no primary", target)
+	}
+	if !targetFound {
+		return "", fmt.Errorf("switchover to %s is rejected, as it is not a replica of %s", target, postgres.Name)
+	}
+	return primary, nil
+}
+
+// switchoverTimeout returns the duration after which a Pending or Running switchover of postgres is failed. The
+// primary waits for the target to catch up for le.SwitchoverCatchUpTimeout, and the leadership is reserved for the
+// target for twice the lease duration, in which it acquires the leadership and fences the primary.
+func switchoverTimeout(postgres *api.Postgres) time.Duration {
+	election := postgres.Spec.LeaderElection
+	if election == nil {
+		return le.SwitchoverCatchUpTimeout
+	}
+	reserved := 2*time.Duration(election.LeaseDurationSeconds)*time.Second + time.Duration(election.FencingTimeoutSeconds)*time.Second
+	if reserved > le.SwitchoverCatchUpTimeout {
+		return reserved
+	}
+	return le.SwitchoverCatchUpTimeout
+}
+
+// expireSwitchover fails the switchover of postgres, that has been Pending or Running without progress for
+// switchoverTimeout since it was requested or last transitioned, as the primary has not picked it up, or the primary
+// or the target has failed while handing over the leadership. The status is updated only if it has not changed since
+// it was read, so that the outcome recorded by the leader election sidecar is not overwritten.
+func (c *Controller) expireSwitchover(postgres *api.Postgres, now time.Time) error {
+	sw := postgres.Status.Switchover
+	timeout := switchoverTimeout(postgres)
+	if sw == nil || (sw.Phase != api.SwitchoverPhasePending && sw.Phase != api.SwitchoverPhaseRunning) ||
+		now.Sub(sw.LastTransitionTime.Time) <= timeout {
+		return nil
+	}
+
+	msg := fmt.Sprintf("switchover from %s to %s has not completed within %v", sw.Primary, sw.Target, timeout)
+	if sw.Phase == api.SwitchoverPhasePending {
+		msg = fmt.Sprintf("switchover from %s to %s has not been started by %s within %v", sw.Primary, sw.Target, sw.Primary, timeout)
+	}
+	in := postgres.DeepCopy()
+	in.Status.Switchover.Phase = api.SwitchoverPhaseFailed
+	in.Status.Switchover.Reason = msg
+	in.Status.Switchover.LastTransitionTime = metav1.NewTime(now)
+	pg, err := c.ExtClient.KubedbV1alpha1().Postgreses(postgres.Namespace).UpdateStatus(in)
+	if err != nil {
+		return err
+	}
+	postgres.Status = pg.Status
+	c.recorder.Event(postgres, core.EventTypeWarning, le.EventReasonSwitchoverFailed, msg)
+	return nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"testing"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	le "kubedb.dev/postgres/pkg/leader_election"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSwitchoverTimeout(t *testing.T) {
+	cases := []struct {
+		name     string
+		election *api.LeaderElectionConfig
+		want     time.Duration
+	}{
+		{"no leader election config", nil, le.SwitchoverCatchUpTimeout},
+		{"catch up is longer", &api.LeaderElectionConfig{LeaseDurationSeconds: 15, FencingTimeoutSeconds: 20}, le.SwitchoverCatchUpTimeout},
+		{"reservation is longer", &api.LeaderElectionConfig{LeaseDurationSeconds: 30, FencingTimeoutSeconds: 30}, 90 * time.Second},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			postgres := &api.Postgres{Spec: api.PostgresSpec{LeaderElection: c.election}}
+			if got := switchoverTimeout(postgres); got != c.want {
+				t.Errorf("switchoverTimeout() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestExpireSwitchover(t *testing.T) {
+	now := time.Now()
+	cases := []struct {
+		name  string
+		phase api.SwitchoverPhase
+		since time.Duration
+		want  api.SwitchoverPhase
+	}{
+		{"running within timeout", api.SwitchoverPhaseRunning, le.SwitchoverCatchUpTimeout, api.SwitchoverPhaseRunning},
+		{"running after timeout", api.SwitchoverPhaseRunning, le.SwitchoverCatchUpTimeout + time.Second, api.SwitchoverPhaseFailed},
+		{"pending within timeout", api.SwitchoverPhasePending, le.SwitchoverCatchUpTimeout, api.SwitchoverPhasePending},
+		{"pending after timeout", api.SwitchoverPhasePending, le.SwitchoverCatchUpTimeout + time.Second, api.SwitchoverPhaseFailed},
+		{"succeeded after timeout", api.SwitchoverPhaseSucceeded, time.Hour, api.SwitchoverPhaseSucceeded},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			postgres := &api.Postgres{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+				Status: api.PostgresStatus{
+					Switchover: &api.PostgresSwitchoverStatus{
+						Primary:            "foo-0",
+						Target:             "foo-1",
+						Phase:              c.phase,
+						LastTransitionTime: metav1.NewTime(now.Add(-c.since)),
+					},
+				},
+			}
+			ctrl := newTestController(nil, postgres)
+			if err := ctrl.expireSwitchover(postgres, now); err != nil {
+				t.Fatal(err)
+			}
+			if postgres.Status.Switchover.Phase != c.want {
+				t.Errorf("expected switchover phase %s, got %s", c.want, postgres.Status.Switchover.Phase)
+			}
+		})
+	}
+}
@@ -0,0 +1,360 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	le "kubedb.dev/postgres/pkg/leader_election"
+
+	"github.com/appscode/go/log"
+	"github.com/appscode/go/types"
+	"gomodules.xyz/cert"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	clientsetscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/reference"
+	core_util "kmodules.xyz/client-go/core/v1"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+const (
+	EventReasonCertificateIssued = "CertificateIssued"
+
+	// AnnotationTLSSerial is set on the pod template to the serial number of the server certificate,
+	// so that the members are restarted with renewed certificates
+	AnnotationTLSSerial = "postgres.kubedb.com/tls-serial"
+
+	// certificates are renewed, once they expire within this duration
+	certRenewBefore = 30 * 24 * time.Hour
+	// period at which the certificates of each Postgres are checked for expiry
+	certSyncPeriod = time.Hour
+
+	// directory that the exporter client certificate is mounted at
+	exporterCertDir = "/tls/exporter"
+)
+
+func caSecretName(postgres *api.Postgres) string {
+	if postgres.Spec.TLS.CASecret != nil {
+		return postgres.Spec.TLS.CASecret.Name
+	}
+	return postgres.OffshootName() + "-ca"
+}
+
+func serverCertSecretName(postgres *api.Postgres) string {
+	return postgres.OffshootName() + "-server-cert"
+}
+
+func replicationCertSecretName(postgres *api.Postgres) string {
+	return postgres.OffshootName() + "-replication-cert"
+}
+
+func exporterCertSecretName(postgres *api.Postgres) string {
+	return postgres.OffshootName() + "-exporter-cert"
+}
+
+// certificate is a leaf certificate issued for postgres, and the secret it is stored in.
+type certificate struct {
+	secretName string
+	config     cert.Config
+}
+
+// certificates returns the server certificate of postgres, and the client certificates used for
+// replication and by the exporter.
+func (c *Controller) certificates(postgres *api.Postgres) []certificate {
+	return []certificate{
+		{
+			secretName: serverCertSecretName(postgres),
+			config: cert.Config{
+				CommonName: postgres.OffshootName(),
+				AltNames:   serverAltNames(postgres, c.GoverningService),
+				Usages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			},
+		},
+		{
+			secretName: replicationCertSecretName(postgres),
+			config: cert.Config{
+				CommonName: "postgres",
+				Usages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			},
+		},
+		{
+			secretName: exporterCertSecretName(postgres),
+			config: cert.Config{
+				CommonName: "postgres",
+				Usages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			},
+		},
+	}
+}
+
+// serverAltNames returns the names that the members of postgres are reached with: the primary and replicas
+// Services, the pods through the governing Service, and localhost for the sidecars in the pods.
+func serverAltNames(postgres *api.Postgres, governingService string) cert.AltNames {
+	names := []string{"localhost"}
+	for _, svc := range []string{postgres.ServiceName(), postgres.ReplicasServiceName()} {
+		names = append(names, svc, svc+"."+postgres.Namespace, svc+"."+postgres.Namespace+".svc")
+	}
+	replicas := int32(1)
+	if postgres.Spec.Replicas != nil {
+		replicas = types.Int32(postgres.Spec.Replicas)
+	}
+	for i := int32(0); i < replicas; i++ {
+		pod := fmt.Sprintf("%s-%d.%s.%s", postgres.OffshootName(), i, governingService, postgres.Namespace)
+		names = append(names, pod, pod+".svc")
+	}
+	return cert.AltNames{
+		DNSNames: names,
+		IPs:      []net.IP{net.ParseIP("127.0.0.1")},
+	}
+}
+
+// ensureTLS issues the certificates of postgres, if TLS is enabled. The certificates are signed by the CA
+// referred by spec.tls.caSecret, or by a CA the operator creates for postgres. They are issued again
+// together, once one of them is about to expire, is no longer signed by the CA, or does not cover the
+// names of the members anymore. The members are restarted with the new certificates by a rolling restart,
+// see AnnotationTLSSerial.
+func (c *Controller) ensureTLS(postgres *api.Postgres) error {
+	if postgres.Spec.TLS == nil {
+		return nil
+	}
+	caCert, caKey, err := c.ensureCA(postgres)
+	if err != nil {
+		return err
+	}
+
+	reason := ""
+	certs := c.certificates(postgres)
+	for _, crt := range certs {
+		secret, err := c.Client.CoreV1().Secrets(postgres.Namespace).Get(crt.secretName, metav1.GetOptions{})
+		if kerr.IsNotFound(err) {
+			reason = fmt.Sprintf("secret %s is not found", crt.secretName)
+			break
+		} else if err != nil {
+			return err
+		}
+		if reason = renewalReason(secret, crt.config, caCert, time.Now()); reason != "" {
+			reason = fmt.Sprintf("certificate in secret %s %s", crt.secretName, reason)
+			break
+		}
+	}
+	if reason == "" {
+		return nil
+	}
+
+	ref, err := reference.GetReference(clientsetscheme.Scheme, postgres)
+	if err != nil {
+		return err
+	}
+	for _, crt := range certs {
+		key, err := cert.NewPrivateKey()
+		if err != nil {
+			return err
+		}
+		signed, err := cert.NewSignedCert(crt.config, key, caCert, caKey)
+		if err != nil {
+			return fmt.Errorf("failed to issue certificate for secret %s. Reason: %v", crt.secretName, err)
+		}
+		meta := metav1.ObjectMeta{
+			Name:      crt.secretName,
+			Namespace: postgres.Namespace,
+		}
+		if _, _, err := core_util.CreateOrPatchSecret(c.Client, meta, func(in *core.Secret) *core.Secret {
+			in.Labels = postgres.OffshootLabels()
+			core_util.EnsureOwnerReference(&in.ObjectMeta, ref)
+			in.Type = core.SecretTypeTLS
+			in.Data = map[string][]byte{
+				core.TLSCertKey:       cert.EncodeCertPEM(signed),
+				core.TLSPrivateKeyKey: cert.EncodePrivateKeyPEM(key),
+				le.TLSCA:              cert.EncodeCertPEM(caCert),
+			}
+			return in
+		}); err != nil {
+			return err
+		}
+	}
+	c.recorder.Eventf(postgres, core.EventTypeNormal, EventReasonCertificateIssued, "certificates are issued, as %s", reason)
+	return nil
+}
+
+// ensureCA returns the CA that signs the certificates of postgres. The CA created by the operator is
+// replaced, once it is about to expire.
+func (c *Controller) ensureCA(postgres *api.Postgres) (*x509.Certificate, crypto.Signer, error) {
+	name := caSecretName(postgres)
+	secret, err := c.Client.CoreV1().Secrets(postgres.Namespace).Get(name, metav1.GetOptions{})
+	if err != nil && !(kerr.IsNotFound(err) && postgres.Spec.TLS.CASecret == nil) {
+		return nil, nil, err
+	}
+	if err == nil {
+		caCert, caKey, err := parseCertAndKey(secret)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read CA from secret %s. Reason: %v", name, err)
+		}
+		if postgres.Spec.TLS.CASecret != nil || time.Until(caCert.NotAfter) > certRenewBefore {
+			return caCert, caKey, nil
+		}
+	}
+
+	key, err := cert.NewPrivateKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	caCert, err := cert.NewSelfSignedCACert(cert.Config{CommonName: postgres.OffshootName() + "-ca"}, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	ref, err := reference.GetReference(clientsetscheme.Scheme, postgres)
+	if err != nil {
+		return nil, nil, err
+	}
+	meta := metav1.ObjectMeta{
+		Name:      name,
+		Namespace: postgres.Namespace,
+	}
+	if _, _, err := core_util.CreateOrPatchSecret(c.Client, meta, func(in *core.Secret) *core.Secret {
+		in.Labels = postgres.OffshootLabels()
+		core_util.EnsureOwnerReference(&in.ObjectMeta, ref)
+		in.Type = core.SecretTypeTLS
+		in.Data = map[string][]byte{
+			core.TLSCertKey:       cert.EncodeCertPEM(caCert),
+			core.TLSPrivateKeyKey: cert.EncodePrivateKeyPEM(key),
+		}
+		return in
+	}); err != nil {
+		return nil, nil, err
+	}
+	c.recorder.Eventf(postgres, core.EventTypeNormal, EventReasonCertificateIssued, "CA is created in secret %s", name)
+	return caCert, key, nil
+}
+
+// parseCertAndKey returns the certificate and private key stored in secret.
+func parseCertAndKey(secret *core.Secret) (*x509.Certificate, crypto.Signer, error) {
+	certs, err := cert.ParseCertsPEM(secret.Data[core.TLSCertKey])
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := cert.ParsePrivateKeyPEM(secret.Data[core.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s is not a signing key", core.TLSPrivateKeyKey)
+	}
+	return certs[0], signer, nil
+}
+
+// renewalReason returns why the certificate stored in secret must be issued again, or "" if it is valid.
+func renewalReason(secret *core.Secret, config cert.Config, caCert *x509.Certificate, now time.Time) string {
+	certs, err := cert.ParseCertsPEM(secret.Data[core.TLSCertKey])
+	if err != nil {
+		return "is invalid"
+	}
+	crt := certs[0]
+	if key, err := cert.ParsePrivateKeyPEM(secret.Data[core.TLSPrivateKeyKey]); err != nil {
+		return "has an invalid key"
+	} else if rsaKey, ok := key.(*rsa.PrivateKey); !ok || !rsaKey.PublicKey.Equal(crt.PublicKey) {
+		return "does not match its key"
+	}
+	if crt.NotAfter.Sub(now) < certRenewBefore {
+		return fmt.Sprintf("expires at %s", crt.NotAfter.Format(time.RFC3339))
+	}
+	if err := crt.CheckSignatureFrom(caCert); err != nil || !bytes.Equal(secret.Data[le.TLSCA], cert.EncodeCertPEM(caCert)) {
+		return "is not signed by the current CA"
+	}
+	if !sameNames(crt.DNSNames, config.AltNames.DNSNames) {
+		return "does not cover the names of the members"
+	}
+	return ""
+}
+
+func sameNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	return reflect.DeepEqual(a, b)
+}
+
+// serverCertSerial returns the serial number of the server certificate of postgres, or "" if TLS is disabled.
+func (c *Controller) serverCertSerial(postgres *api.Postgres) (string, error) {
+	if postgres.Spec.TLS == nil {
+		return "", nil
+	}
+	secret, err := c.Client.CoreV1().Secrets(postgres.Namespace).Get(serverCertSecretName(postgres), metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	certs, err := cert.ParseCertsPEM(secret.Data[core.TLSCertKey])
+	if err != nil {
+		return "", err
+	}
+	return certs[0].SerialNumber.Text(16), nil
+}
+
+// caBundle returns the certificate of the CA that signs the certificates of postgres.
+func (c *Controller) caBundle(postgres *api.Postgres) ([]byte, error) {
+	secret, err := c.Client.CoreV1().Secrets(postgres.Namespace).Get(caSecretName(postgres), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return secret.Data[core.TLSCertKey], nil
+}
+
+// syncCertificates processes the Postgres objects again, whose certificates are about to expire.
+// A CA provided by the user is left to the user to renew.
+func (c *Controller) syncCertificates() {
+	dbs, err := c.pgLister.List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	for _, postgres := range dbs {
+		if postgres.DeletionTimestamp != nil || postgres.Spec.TLS == nil {
+			continue
+		}
+		names := []string{serverCertSecretName(postgres), replicationCertSecretName(postgres), exporterCertSecretName(postgres)}
+		if postgres.Spec.TLS.CASecret == nil {
+			names = append(names, caSecretName(postgres))
+		}
+		for _, name := range names {
+			secret, err := c.Client.CoreV1().Secrets(postgres.Namespace).Get(name, metav1.GetOptions{})
+			if err != nil {
+				log.Errorf("failed to check certificates of Postgres %s/%s. Reason: %v", postgres.Namespace, postgres.Name, err)
+				break
+			}
+			certs, err := cert.ParseCertsPEM(secret.Data[core.TLSCertKey])
+			if err != nil || time.Until(certs[0].NotAfter) < certRenewBefore {
+				queue.Enqueue(c.pgQueue.GetQueue(), postgres)
+				break
+			}
+		}
+	}
+}
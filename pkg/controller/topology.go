@@ -0,0 +1,316 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	"kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1/util"
+	le "kubedb.dev/postgres/pkg/leader_election"
+
+	"github.com/appscode/go/log"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// period at which the replication status of the members of each Postgres is collected
+const memberStatusPeriod = 30 * time.Second
+
+// syncMemberStatuses records the role and replication status of the members of every running Postgres,
+// as published by their leader election sidecars, along with the conditions derived from them.
+func (c *Controller) syncMemberStatuses() {
+	dbs, err := c.pgLister.List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	for _, postgres := range dbs {
+		if postgres.DeletionTimestamp != nil || postgres.Status.Phase != api.DatabasePhaseRunning {
+			continue
+		}
+		if err := c.syncMemberStatus(postgres.DeepCopy()); err != nil {
+			log.Errorf("failed to update member status of Postgres %s/%s. Reason: %v", postgres.Namespace, postgres.Name, err)
+		}
+	}
+}
+
+func (c *Controller) syncMemberStatus(postgres *api.Postgres) error {
+	pods, err := c.Client.CoreV1().Pods(postgres.Namespace).List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(postgres.OffshootSelectors()).String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	// leader election may not be defaulted, if the mutating webhook is disabled
+	spec := postgres.Spec.DeepCopy()
+	spec.SetDefaults()
+
+	now := metav1.Now()
+	members, primary := memberStatuses(postgres, spec.LeaderElection, pods.Items, now)
+	conditions := postgresConditions(postgres, spec.LeaderElection, members, primary, now)
+	ready := readyMembers(members)
+	archive := archiveHealth(postgres, primary)
+	var primaryName string
+	if primary != nil {
+		primaryName = primary.pod.Name
+	}
+
+	if postgres.Status.Primary == primaryName &&
+		postgres.Status.ReadyMembers == ready &&
+		reflect.DeepEqual(postgres.Status.Members, members) &&
+		reflect.DeepEqual(postgres.Status.Conditions, conditions) &&
+		reflect.DeepEqual(postgres.Status.Archive, archive) {
+		return nil
+	}
+	_, err = util.UpdatePostgresStatus(c.ExtClient.KubedbV1alpha1(), postgres, func(in *api.PostgresStatus) *api.PostgresStatus {
+		in.Primary = primaryName
+		in.ReadyMembers = ready
+		in.Members = members
+		in.Conditions = conditions
+		if archive != nil {
+			// keep the pruning and recoverability window, that are recorded by the other archive tasks meanwhile
+			if in.Archive == nil {
+				in.Archive = &api.PostgresArchiveStatus{}
+			}
+			in.Archive.LastArchivedWAL = archive.LastArchivedWAL
+			in.Archive.LastArchivedTime = archive.LastArchivedTime
+			in.Archive.FailedCount = archive.FailedCount
+			in.Archive.LastFailedWAL = archive.LastFailedWAL
+			in.Archive.LastFailedTime = archive.LastFailedTime
+			in.Archive.LastError = archive.LastError
+			in.Archive.PendingWALFiles = archive.PendingWALFiles
+		}
+		return in
+	})
+	return err
+}
+
+// primaryMember is the pod labeled as primary, with the state published by its sidecar.
+type primaryMember struct {
+	pod   *core.Pod
+	ready bool
+	state le.MemberState
+	// false, if the sidecar has not published its state recently
+	known bool
+	// number of pods that are labeled as primary
+	count int
+}
+
+// memberStatuses returns the status of every member of postgres, ordered by name, and the primary if any.
+// The transition time of a member is kept, as long as its role and readiness are unchanged.
+func memberStatuses(postgres *api.Postgres, election *api.LeaderElectionConfig, pods []core.Pod, now metav1.Time) ([]api.PostgresMemberStatus, *primaryMember) {
+	// like the sidecars, positions are stale after two lease durations
+	staleAfter := 2 * time.Duration(election.LeaseDurationSeconds) * time.Second
+	previous := map[string]api.PostgresMemberStatus{}
+	for _, m := range postgres.Status.Members {
+		previous[m.Name] = m
+	}
+
+	sort.Slice(pods, func(i, j int) bool { return pods[i].Name < pods[j].Name })
+	var primary *primaryMember
+	states := make([]le.MemberState, len(pods))
+	known := make([]bool, len(pods))
+	for i := range pods {
+		states[i], known[i] = le.ReadMemberState(&pods[i], staleAfter)
+		if pods[i].Labels[NodeRole] != le.RolePrimary {
+			continue
+		}
+		if primary == nil {
+			primary = &primaryMember{pod: &pods[i], ready: le.IsPodReady(&pods[i]), state: states[i], known: known[i]}
+		}
+		primary.count++
+	}
+
+	members := make([]api.PostgresMemberStatus, 0, len(pods))
+	for i := range pods {
+		pod := &pods[i]
+		m := api.PostgresMemberStatus{
+			Name:               pod.Name,
+			Role:               pod.Labels[NodeRole],
+			Ready:              le.IsPodReady(pod),
+			LastTransitionTime: now,
+		}
+		if prev, found := previous[pod.Name]; found && prev.Role == m.Role && prev.Ready == m.Ready {
+			m.LastTransitionTime = prev.LastTransitionTime
+		}
+		if known[i] {
+			m.LSN = pod.Annotations[le.AnnotationWalLSN]
+			m.Timeline = states[i].Timeline
+			if m.Role != le.RolePrimary && primary != nil && primary.known {
+				var lagBytes int64
+				if primary.state.LSN > states[i].LSN {
+					lagBytes = int64(primary.state.LSN - states[i].LSN)
+				}
+				lagSeconds := states[i].ReplayLagSeconds
+				m.LagBytes, m.LagSeconds = &lagBytes, &lagSeconds
+			}
+		}
+		members = append(members, m)
+	}
+	return members, primary
+}
+
+// postgresConditions returns the conditions of postgres for its members. The transition time of a condition
+// is kept, as long as its status is unchanged.
+func postgresConditions(postgres *api.Postgres, election *api.LeaderElectionConfig, members []api.PostgresMemberStatus, primary *primaryMember, now metav1.Time) []api.PostgresCondition {
+	primaryAvailable := api.PostgresCondition{Type: api.PostgresConditionPrimaryAvailable, Status: core.ConditionTrue, Reason: "PrimaryReady"}
+	switch {
+	case primary == nil:
+		primaryAvailable.Status, primaryAvailable.Reason, primaryAvailable.Message = core.ConditionFalse, "NoPrimary", "no member is running as primary"
+	case primary.count > 1:
+		primaryAvailable.Status, primaryAvailable.Reason = core.ConditionFalse, "MultiplePrimaries"
+		primaryAvailable.Message = fmt.Sprintf("%d members are labeled as primary", primary.count)
+	case !primary.ready:
+		primaryAvailable.Status, primaryAvailable.Reason = core.ConditionFalse, "PrimaryNotReady"
+		primaryAvailable.Message = fmt.Sprintf("primary %s is not ready", primary.pod.Name)
+	default:
+		primaryAvailable.Message = fmt.Sprintf("%s is running as primary", primary.pod.Name)
+	}
+
+	var notReady, unknown, lagging []string
+	for _, m := range members {
+		switch {
+		case m.Role == le.RolePrimary:
+		case !m.Ready:
+			notReady = append(notReady, m.Name)
+		case m.LagBytes == nil:
+			unknown = append(unknown, m.Name)
+		case *m.LagBytes > maximumLag(election):
+			lagging = append(lagging, m.Name)
+		}
+	}
+	replicasInSync := api.PostgresCondition{Type: api.PostgresConditionReplicasInSync, Status: core.ConditionTrue, Reason: "InSync",
+		Message: "every replica is ready and caught up with the primary"}
+	switch {
+	case len(notReady) > 0:
+		replicasInSync.Status, replicasInSync.Reason = core.ConditionFalse, "ReplicaNotReady"
+		replicasInSync.Message = fmt.Sprintf("replicas %s are not ready", strings.Join(notReady, ","))
+	case len(lagging) > 0:
+		replicasInSync.Status, replicasInSync.Reason = core.ConditionFalse, "ReplicaLagging"
+		replicasInSync.Message = fmt.Sprintf("replicas %s are more than %d bytes behind the primary",
+			strings.Join(lagging, ","), maximumLag(election))
+	case len(unknown) > 0:
+		replicasInSync.Status, replicasInSync.Reason = core.ConditionUnknown, "LagUnknown"
+		replicasInSync.Message = fmt.Sprintf("replication lag of replicas %s is unknown", strings.Join(unknown, ","))
+	}
+
+	archiving := api.PostgresCondition{Type: api.PostgresConditionArchiving, Status: core.ConditionTrue, Reason: "Archived"}
+	switch {
+	case postgres.Spec.Archiver == nil:
+		archiving.Status, archiving.Reason, archiving.Message = core.ConditionFalse, "NotConfigured", "WAL archiving is not configured"
+	case primary == nil || !primary.known:
+		archiving.Status, archiving.Reason, archiving.Message = core.ConditionUnknown, "PrimaryUnknown", "archiver state of the primary is unknown"
+	case primary.state.LastFailedArchiveTime.After(primary.state.LastArchivedTime):
+		archiving.Status, archiving.Reason = core.ConditionFalse, "ArchiveFailed"
+		archiving.Message = fmt.Sprintf("archiving WAL file %s failed at %s", primary.state.LastFailedWAL,
+			primary.state.LastFailedArchiveTime.UTC().Format(time.RFC3339))
+		if primary.state.LastArchiveError != "" {
+			archiving.Message += ": " + lastLine(primary.state.LastArchiveError)
+		}
+	case primary.state.PendingWALFiles > int64(maximumPendingWALFiles(postgres)):
+		archiving.Status, archiving.Reason = core.ConditionFalse, "ArchiveBehind"
+		archiving.Message = fmt.Sprintf("%d WAL files are waiting to be archived, more than %d",
+			primary.state.PendingWALFiles, maximumPendingWALFiles(postgres))
+	case primary.state.LastArchivedTime.IsZero():
+		archiving.Status, archiving.Reason, archiving.Message = core.ConditionUnknown, "NoWALArchived", "no WAL file has been archived yet"
+	default:
+		archiving.Message = fmt.Sprintf("last WAL file was archived at %s", primary.state.LastArchivedTime.UTC().Format(time.RFC3339))
+	}
+
+	ready := api.PostgresCondition{Type: api.PostgresConditionReady, Status: core.ConditionTrue, Reason: "AllMembersReady",
+		Message: "primary is available and every member is ready"}
+	var replicas int32 = 1
+	if postgres.Spec.Replicas != nil {
+		replicas = *postgres.Spec.Replicas
+	}
+	switch {
+	case primaryAvailable.Status != core.ConditionTrue:
+		ready.Status, ready.Reason, ready.Message = core.ConditionFalse, primaryAvailable.Reason, primaryAvailable.Message
+	case readyMembers(members) < replicas:
+		ready.Status, ready.Reason = core.ConditionFalse, "MembersNotReady"
+		ready.Message = fmt.Sprintf("%d of %d members are ready", readyMembers(members), replicas)
+	}
+
+	conditions := []api.PostgresCondition{ready, primaryAvailable, replicasInSync, archiving}
+	for i := range conditions {
+		conditions[i].LastTransitionTime = now
+		for _, prev := range postgres.Status.Conditions {
+			if prev.Type == conditions[i].Type && prev.Status == conditions[i].Status {
+				conditions[i].LastTransitionTime = prev.LastTransitionTime
+			}
+		}
+	}
+	return conditions
+}
+
+// maximumPendingWALFiles returns the number of WAL files waiting to be archived, above which archiving is behind.
+func maximumPendingWALFiles(postgres *api.Postgres) int32 {
+	if postgres.Spec.Archiver != nil && postgres.Spec.Archiver.MaximumPendingWALFiles > 0 {
+		return postgres.Spec.Archiver.MaximumPendingWALFiles
+	}
+	return api.DefaultMaximumPendingWALFiles
+}
+
+// lastLine returns the last non-empty line of out, which tells why a command failed.
+func lastLine(out string) string {
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	return strings.TrimSpace(lines[len(lines)-1])
+}
+
+// archiveHealth returns the archive status of postgres, with the state of the archiver published by the sidecar
+// of the primary. The status is unchanged, if the state of the primary is unknown.
+func archiveHealth(postgres *api.Postgres, primary *primaryMember) *api.PostgresArchiveStatus {
+	if postgres.Spec.Archiver == nil || primary == nil || !primary.known {
+		return postgres.Status.Archive
+	}
+	archive := &api.PostgresArchiveStatus{}
+	if postgres.Status.Archive != nil {
+		archive = postgres.Status.Archive.DeepCopy()
+	}
+	state := primary.state
+	archive.LastArchivedWAL = state.LastArchivedWAL
+	archive.LastArchivedTime = optionalTime(state.LastArchivedTime)
+	archive.FailedCount = state.ArchiveFailedCount
+	archive.LastFailedWAL = state.LastFailedWAL
+	archive.LastFailedTime = optionalTime(state.LastFailedArchiveTime)
+	archive.LastError = state.LastArchiveError
+	archive.PendingWALFiles = state.PendingWALFiles
+	return archive
+}
+
+func optionalTime(t time.Time) *metav1.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &metav1.Time{Time: t}
+}
+
+func readyMembers(members []api.PostgresMemberStatus) int32 {
+	var ready int32
+	for _, m := range members {
+		if m.Ready {
+			ready++
+		}
+	}
+	return ready
+}
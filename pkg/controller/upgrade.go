@@ -0,0 +1,659 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	catalog "kubedb.dev/apimachinery/apis/catalog/v1alpha1"
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	"kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1/util"
+	le "kubedb.dev/postgres/pkg/leader_election"
+
+	"github.com/appscode/go/log"
+	"github.com/appscode/go/types"
+	apps "k8s.io/api/apps/v1"
+	batch "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	app_util "kmodules.xyz/client-go/apps/v1"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+const (
+	// Annotation of the StatefulSet with the PostgresVersion that the data directories belong to
+	AnnotationPostgresVersion = "postgres.kubedb.com/version"
+
+	EventReasonMajorVersionUpgrade       = "MajorVersionUpgrade"
+	EventReasonMajorVersionUpgradeFailed = "MajorVersionUpgradeFailed"
+
+	// period at which Postgres objects with an upgrade in progress are processed again
+	upgradeSyncPeriod = 10 * time.Second
+	// maximum duration for the upgraded primary to become ready, before the upgrade is rolled back
+	upgradeRolloutTimeout = 10 * time.Minute
+
+	// the installation of the old version is copied here for pg_upgrade
+	upgradeOldInstallation = "/var/upgrade/old"
+	// the replicas are seeded again from the upgraded primary, the old data directories are kept for rollback
+	reseedScript = `if [ -d "$PGDATA" ]; then rm -rf "$PV/data.rollback" && mv "$PGDATA" "$PV/data.rollback"; fi`
+	// restores the data directories that were put aside by the upgrade. The job runs the old image, data directories
+	// that still belong to its major version have not been touched by the upgrade.
+	rollbackScript = `rm -rf "$PV/data.upgrade"
+if [ "$(cat "$PGDATA/PG_VERSION" 2>/dev/null)" != "$PG_MAJOR" ] && [ -d "$PV/data.rollback" ]; then
+  rm -rf "$PGDATA" && mv "$PV/data.rollback" "$PGDATA"
+fi`
+)
+
+// ensureMajorVersionUpgrade upgrades the data directories of postgres with pg_upgrade, when spec.version is
+// changed to a newer major version. It returns true while the StatefulSet is managed by the upgrade, which is
+// until the upgrade succeeds, or spec.version is reverted after the upgrade has failed.
+//
+// The upgrade takes a safety snapshot, switches the primary over to the first member, stops all members,
+// upgrades the data directory of the first member in a Job and puts the data directories of the replicas aside.
+// Then the members are started with the new version, and the replicas are seeded again from the primary.
+// If the upgrade fails, the old data directories are restored and the members are started with the old version.
+func (c *Controller) ensureMajorVersionUpgrade(postgres *api.Postgres) (bool, error) {
+	if upgrade := postgres.Status.Upgrade; upgrade != nil && upgradeInProgress(upgrade.Phase) {
+		return true, c.continueUpgrade(postgres)
+	}
+
+	statefulSet, err := c.Client.AppsV1().StatefulSets(postgres.Namespace).Get(postgres.OffshootName(), metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	from, err := c.statefulSetVersion(statefulSet)
+	if err != nil {
+		return false, err
+	}
+	to := string(postgres.Spec.Version)
+
+	upgrade := postgres.Status.Upgrade
+	if from == "" || from == to {
+		if upgrade != nil && upgrade.Phase != api.UpgradePhaseSucceeded && upgrade.FromVersion == to {
+			// the failed upgrade is forgotten once spec.version is reverted, so that it can be requested again
+			return false, c.updateUpgrade(postgres, nil)
+		}
+		return false, nil
+	}
+	if upgrade != nil && upgrade.FromVersion == from && upgrade.ToVersion == to {
+		// the upgrade has been rejected or rolled back, it is not retried until spec.version is reverted
+		return true, nil
+	}
+
+	fromVersion, err := c.ExtClient.CatalogV1alpha1().PostgresVersions().Get(from, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	toVersion, err := c.ExtClient.CatalogV1alpha1().PostgresVersions().Get(to, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	major, err := isMajorVersionUpgrade(fromVersion, toVersion)
+	if err == nil && major && postgres.Spec.StorageType == api.StorageTypeEphemeral {
+		err = fmt.Errorf("data directories of ephemeral storage can not be upgraded")
+	}
+	if err != nil {
+		msg := fmt.Sprintf("upgrade from %s to %s is rejected. Reason: %v. Revert spec.version to %s to continue managing the database", from, to, err, from)
+		c.recorder.Event(postgres, core.EventTypeWarning, EventReasonMajorVersionUpgradeFailed, msg)
+		return true, c.updateUpgrade(postgres, &api.PostgresUpgradeStatus{
+			FromVersion:        from,
+			ToVersion:          to,
+			Phase:              api.UpgradePhaseFailed,
+			Reason:             msg,
+			LastTransitionTime: metav1.Now(),
+		})
+	}
+	if !major {
+		// minor versions share the data directory format, the image is replaced by a rolling update
+		return false, nil
+	}
+
+	msg := fmt.Sprintf("upgrade from %s to %s is started", from, to)
+	c.recorder.Event(postgres, core.EventTypeNormal, EventReasonMajorVersionUpgrade, msg)
+	if err := c.updateUpgrade(postgres, &api.PostgresUpgradeStatus{
+		FromVersion:        from,
+		ToVersion:          to,
+		Primary:            fmt.Sprintf("%s-0", statefulSet.Name),
+		Phase:              api.UpgradePhaseSnapshotting,
+		Reason:             msg,
+		LastTransitionTime: metav1.Now(),
+	}); err != nil {
+		return true, err
+	}
+	return true, c.continueUpgrade(postgres)
+}
+
+// continueUpgrade moves the upgrade of postgres forward as far as it can without waiting.
+func (c *Controller) continueUpgrade(postgres *api.Postgres) error {
+	upgrade := postgres.Status.Upgrade
+	switch upgrade.Phase {
+	case api.UpgradePhaseSnapshotting:
+		return c.upgradeSnapshot(postgres)
+	case api.UpgradePhaseSwitchingOver:
+		return c.upgradeSwitchover(postgres)
+	case api.UpgradePhaseStopping:
+		stopped, err := c.stopMembers(postgres)
+		if err != nil || !stopped {
+			return err
+		}
+		return c.setUpgradePhase(postgres, api.UpgradePhaseUpgrading, fmt.Sprintf("upgrading data directory of %s", upgrade.Primary))
+	case api.UpgradePhaseUpgrading:
+		return c.upgradeDataDirectories(postgres)
+	case api.UpgradePhaseRollingOut:
+		return c.upgradeRollout(postgres)
+	case api.UpgradePhaseRollingBack:
+		return c.upgradeRollback(postgres)
+	}
+	return nil
+}
+
+// upgradeSnapshot takes a safety snapshot with the backup storage of spec.backupSchedule.
+func (c *Controller) upgradeSnapshot(postgres *api.Postgres) error {
+	upgrade := postgres.Status.Upgrade
+	schedule := postgres.Spec.BackupSchedule
+	if schedule == nil {
+		c.recorder.Event(postgres, core.EventTypeWarning, EventReasonMajorVersionUpgrade,
+			"no safety snapshot is taken, as spec.backupSchedule is not set. The old data directories are kept for rollback only")
+		return c.setUpgradePhase(postgres, api.UpgradePhaseSwitchingOver, fmt.Sprintf("switching primary over to %s", upgrade.Primary))
+	}
+
+	if upgrade.Snapshot == "" {
+		snapshot, err := c.ExtClient.KubedbV1alpha1().Snapshots(postgres.Namespace).Create(&api.Snapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-upgrade-%s", postgres.Name, time.Now().UTC().Format("20060102-150405")),
+				Namespace: postgres.Namespace,
+				Labels: map[string]string{
+					api.LabelDatabaseKind: api.ResourceKindPostgres,
+					api.LabelDatabaseName: postgres.Name,
+				},
+			},
+			Spec: api.SnapshotSpec{
+				DatabaseName:       postgres.Name,
+				Backend:            schedule.Backend,
+				StorageType:        schedule.StorageType,
+				PodTemplate:        schedule.PodTemplate,
+				PodVolumeClaimSpec: schedule.PodVolumeClaimSpec,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create safety snapshot. Reason: %v", err)
+		}
+		upgrade = upgrade.DeepCopy()
+		upgrade.Snapshot = snapshot.Name
+		upgrade.Reason = fmt.Sprintf("taking safety snapshot %s", snapshot.Name)
+		return c.updateUpgrade(postgres, upgrade)
+	}
+
+	snapshot, err := c.ExtClient.KubedbV1alpha1().Snapshots(postgres.Namespace).Get(upgrade.Snapshot, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	switch snapshot.Status.Phase {
+	case api.SnapshotPhaseSucceeded:
+		return c.setUpgradePhase(postgres, api.UpgradePhaseSwitchingOver, fmt.Sprintf("switching primary over to %s", upgrade.Primary))
+	case api.SnapshotPhaseFailed:
+		return c.failUpgrade(postgres, api.UpgradePhaseFailed, fmt.Sprintf("safety snapshot %s failed. Reason: %s", snapshot.Name, snapshot.Status.Reason))
+	}
+	return nil
+}
+
+// upgradeSwitchover makes the member, whose data directory is upgraded, the primary. It is the first member,
+// because the StatefulSet starts it before the others.
+func (c *Controller) upgradeSwitchover(postgres *api.Postgres) error {
+	upgrade := postgres.Status.Upgrade
+	primary, err := c.primaryMember(postgres)
+	if err != nil || primary == "" {
+		return err
+	}
+	if primary == upgrade.Primary {
+		return c.setUpgradePhase(postgres, api.UpgradePhaseStopping, "stopping all members")
+	}
+
+	if sw := postgres.Status.Switchover; sw != nil && sw.Target == upgrade.Primary && !sw.LastTransitionTime.Before(&upgrade.LastTransitionTime) {
+		if sw.Phase == api.SwitchoverPhaseFailed {
+			return c.failUpgrade(postgres, api.UpgradePhaseFailed, fmt.Sprintf("switchover to %s failed. Reason: %s", upgrade.Primary, sw.Reason))
+		}
+		// the switchover is in progress, or the pods are not relabeled yet
+		return nil
+	}
+	return c.requestSwitchover(postgres, upgrade.Primary)
+}
+
+// upgradeDataDirectories runs pg_upgrade on the data directory of the primary, and puts the data directories
+// of the replicas aside.
+func (c *Controller) upgradeDataDirectories(postgres *api.Postgres) error {
+	upgrade := postgres.Status.Upgrade
+	fromVersion, err := c.ExtClient.CatalogV1alpha1().PostgresVersions().Get(upgrade.FromVersion, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	toVersion, err := c.ExtClient.CatalogV1alpha1().PostgresVersions().Get(upgrade.ToVersion, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	var jobs []*batch.Job
+	for i := 0; i < int(types.Int32(postgres.Spec.Replicas)); i++ {
+		member := fmt.Sprintf("%s-%d", postgres.OffshootName(), i)
+		job := c.dataDirectoryJob(postgres, fmt.Sprintf("%s-upgrade-%d", postgres.OffshootName(), i), member, toVersion.Spec.DB.Image,
+			[]string{"sh", "-c", reseedScript})
+		if member == upgrade.Primary {
+			job.Spec.Template.Spec.Containers[0].Command = []string{"/scripts/upgrade.sh"}
+			job.Spec.Template.Spec.Containers[0].Env = append(job.Spec.Template.Spec.Containers[0].Env, core.EnvVar{
+				Name:  "OLD_INSTALLATION",
+				Value: upgradeOldInstallation,
+			})
+			job = withOldInstallation(job, fromVersion)
+		}
+		jobs = append(jobs, job)
+	}
+
+	done, err := c.runDataDirectoryJobs(jobs)
+	if err != nil {
+		return c.failUpgrade(postgres, api.UpgradePhaseRollingBack, fmt.Sprintf("upgrade of data directories failed, rolling back. Reason: %v", err))
+	}
+	if !done {
+		return nil
+	}
+	if err := c.setUpgradePhase(postgres, api.UpgradePhaseRollingOut, fmt.Sprintf("starting members with %s", upgrade.ToVersion)); err != nil {
+		return err
+	}
+	return c.deleteDataDirectoryJobs(jobs)
+}
+
+// upgradeRollout starts the members with the new version. The upgrade is rolled back, if the upgraded primary
+// does not become ready in time.
+func (c *Controller) upgradeRollout(postgres *api.Postgres) error {
+	upgrade := postgres.Status.Upgrade
+	if time.Since(upgrade.LastTransitionTime.Time) > upgradeRolloutTimeout {
+		return c.failUpgrade(postgres, api.UpgradePhaseRollingBack,
+			fmt.Sprintf("%s did not become ready as primary within %v, rolling back", upgrade.Primary, upgradeRolloutTimeout))
+	}
+
+	toVersion, err := c.ExtClient.CatalogV1alpha1().PostgresVersions().Get(upgrade.ToVersion, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if _, err := c.ensurePostgresNode(postgres, toVersion); err != nil {
+		log.Errorf("failed to start members of Postgres %s/%s with %s. Reason: %v", postgres.Namespace, postgres.Name, upgrade.ToVersion, err)
+		return nil
+	}
+	ready, err := c.isPrimaryReady(postgres, upgrade.Primary)
+	if err != nil || !ready {
+		return err
+	}
+
+	msg := fmt.Sprintf("upgrade from %s to %s succeeded, old data directories are kept until the next upgrade", upgrade.FromVersion, upgrade.ToVersion)
+	c.recorder.Event(postgres, core.EventTypeNormal, EventReasonMajorVersionUpgrade, msg)
+	return c.setUpgradePhase(postgres, api.UpgradePhaseSucceeded, msg)
+}
+
+// upgradeRollback stops the members, restores their old data directories and starts them with the old version.
+func (c *Controller) upgradeRollback(postgres *api.Postgres) error {
+	upgrade := postgres.Status.Upgrade
+	fromVersion, err := c.ExtClient.CatalogV1alpha1().PostgresVersions().Get(upgrade.FromVersion, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	var jobs []*batch.Job
+	for i := 0; i < int(types.Int32(postgres.Spec.Replicas)); i++ {
+		member := fmt.Sprintf("%s-%d", postgres.OffshootName(), i)
+		jobs = append(jobs, c.dataDirectoryJob(postgres, fmt.Sprintf("%s-rollback-%d", postgres.OffshootName(), i), member, fromVersion.Spec.DB.Image,
+			[]string{"sh", "-c", rollbackScript}))
+	}
+	if _, err := c.Client.BatchV1().Jobs(postgres.Namespace).Get(jobs[0].Name, metav1.GetOptions{}); kerr.IsNotFound(err) {
+		// the members must be stopped, before their data directories are restored
+		stopped, err := c.stopMembers(postgres)
+		if err != nil || !stopped {
+			return err
+		}
+	}
+
+	done, err := c.runDataDirectoryJobs(jobs)
+	if err != nil {
+		return c.failUpgrade(postgres, api.UpgradePhaseFailed,
+			fmt.Sprintf("rollback failed, old data directories have to be restored from data.rollback manually. Reason: %v", err))
+	}
+	if !done {
+		return nil
+	}
+	if _, err := c.ensurePostgresNode(postgres, fromVersion); err != nil {
+		log.Errorf("failed to start members of Postgres %s/%s with %s. Reason: %v", postgres.Namespace, postgres.Name, upgrade.FromVersion, err)
+		return nil
+	}
+
+	msg := fmt.Sprintf("upgrade to %s is rolled back, members run with %s. Revert spec.version to %s to continue managing the database",
+		upgrade.ToVersion, upgrade.FromVersion, upgrade.FromVersion)
+	c.recorder.Event(postgres, core.EventTypeWarning, EventReasonMajorVersionUpgradeFailed, msg)
+	if err := c.setUpgradePhase(postgres, api.UpgradePhaseRolledBack, msg); err != nil {
+		return err
+	}
+	return c.deleteDataDirectoryJobs(jobs)
+}
+
+// stopMembers scales the StatefulSet of postgres down to zero, and returns true once all its pods are gone.
+func (c *Controller) stopMembers(postgres *api.Postgres) (bool, error) {
+	statefulSet, err := c.Client.AppsV1().StatefulSets(postgres.Namespace).Get(postgres.OffshootName(), metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	if types.Int32(statefulSet.Spec.Replicas) != 0 {
+		if _, _, err := app_util.PatchStatefulSet(c.Client, statefulSet, func(in *apps.StatefulSet) *apps.StatefulSet {
+			in.Spec.Replicas = types.Int32P(0)
+			return in
+		}); err != nil {
+			return false, err
+		}
+	}
+	pods, err := c.Client.CoreV1().Pods(postgres.Namespace).List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(postgres.OffshootSelectors()).String(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(pods.Items) == 0, nil
+}
+
+// dataDirectoryJob returns a Job that runs command with image on the data volume of member.
+func (c *Controller) dataDirectoryJob(postgres *api.Postgres, name, member, image string, command []string) *batch.Job {
+	job := &batch.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: postgres.Namespace,
+			// not labeled with the database kind, as completed jobs of that kind are deleted by the snapshot controller
+			Labels: map[string]string{
+				api.LabelDatabaseName: postgres.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: api.SchemeGroupVersion.String(),
+					Kind:       api.ResourceKindPostgres,
+					Name:       postgres.Name,
+					UID:        postgres.UID,
+				},
+			},
+		},
+		Spec: batch.JobSpec{
+			BackoffLimit: types.Int32P(2),
+			Template: core.PodTemplateSpec{
+				Spec: core.PodSpec{
+					Containers: []core.Container{
+						{
+							Name:            "upgrade",
+							Image:           image,
+							ImagePullPolicy: core.PullIfNotPresent,
+							Command:         command,
+							Resources:       postgres.Spec.PodTemplate.Spec.Resources,
+							VolumeMounts: []core.VolumeMount{
+								{
+									Name:      "data",
+									MountPath: "/var/pv",
+								},
+							},
+						},
+					},
+					Volumes: []core.Volume{
+						{
+							Name: "data",
+							VolumeSource: core.VolumeSource{
+								PersistentVolumeClaim: &core.PersistentVolumeClaimVolumeSource{
+									ClaimName: fmt.Sprintf("data-%s", member),
+								},
+							},
+						},
+					},
+					RestartPolicy:     core.RestartPolicyNever,
+					NodeSelector:      postgres.Spec.PodTemplate.Spec.NodeSelector,
+					Affinity:          postgres.Spec.PodTemplate.Spec.Affinity,
+					SchedulerName:     postgres.Spec.PodTemplate.Spec.SchedulerName,
+					Tolerations:       postgres.Spec.PodTemplate.Spec.Tolerations,
+					PriorityClassName: postgres.Spec.PodTemplate.Spec.PriorityClassName,
+					Priority:          postgres.Spec.PodTemplate.Spec.Priority,
+					SecurityContext:   postgres.Spec.PodTemplate.Spec.SecurityContext,
+					ImagePullSecrets:  postgres.Spec.PodTemplate.Spec.ImagePullSecrets,
+				},
+			},
+		},
+	}
+	if c.EnableRBAC {
+		// the job runs with the privileges of the database pods
+		job.Spec.Template.Spec.ServiceAccountName = postgres.Spec.PodTemplate.Spec.ServiceAccountName
+	}
+	return job
+}
+
+// withOldInstallation copies the installation of the old version into the job, so that pg_upgrade can run
+// the binaries of both versions.
+func withOldInstallation(job *batch.Job, fromVersion *catalog.PostgresVersion) *batch.Job {
+	job.Spec.Template.Spec.InitContainers = append(job.Spec.Template.Spec.InitContainers, core.Container{
+		Name:            "old-installation",
+		Image:           fromVersion.Spec.DB.Image,
+		ImagePullPolicy: core.PullIfNotPresent,
+		Command:         []string{"sh", "-c", fmt.Sprintf("cp -a /usr/local/. %s/", upgradeOldInstallation)},
+		VolumeMounts: []core.VolumeMount{
+			{
+				Name:      "old-installation",
+				MountPath: upgradeOldInstallation,
+			},
+		},
+	})
+	job.Spec.Template.Spec.Containers[0].VolumeMounts = append(job.Spec.Template.Spec.Containers[0].VolumeMounts, core.VolumeMount{
+		Name:      "old-installation",
+		MountPath: upgradeOldInstallation,
+	})
+	job.Spec.Template.Spec.Volumes = append(job.Spec.Template.Spec.Volumes, core.Volume{
+		Name: "old-installation",
+		VolumeSource: core.VolumeSource{
+			EmptyDir: &core.EmptyDirVolumeSource{},
+		},
+	})
+	return job
+}
+
+// runDataDirectoryJobs creates the jobs that do not exist yet. It returns true once all of them have succeeded,
+// or an error if any of them has failed.
+func (c *Controller) runDataDirectoryJobs(jobs []*batch.Job) (bool, error) {
+	done := true
+	for _, job := range jobs {
+		cur, err := c.Client.BatchV1().Jobs(job.Namespace).Get(job.Name, metav1.GetOptions{})
+		if kerr.IsNotFound(err) {
+			if _, err := c.Client.BatchV1().Jobs(job.Namespace).Create(job); err != nil {
+				return false, err
+			}
+			done = false
+			continue
+		} else if err != nil {
+			return false, err
+		}
+		for _, cond := range cur.Status.Conditions {
+			if cond.Type == batch.JobFailed && cond.Status == core.ConditionTrue {
+				return false, fmt.Errorf("job %s failed. Reason: %s", cur.Name, cond.Message)
+			}
+		}
+		if cur.Status.Succeeded == 0 {
+			done = false
+		}
+	}
+	return done, nil
+}
+
+func (c *Controller) deleteDataDirectoryJobs(jobs []*batch.Job) error {
+	policy := metav1.DeletePropagationBackground
+	for _, job := range jobs {
+		err := c.Client.BatchV1().Jobs(job.Namespace).Delete(job.Name, &metav1.DeleteOptions{PropagationPolicy: &policy})
+		if err != nil && !kerr.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// primaryMember returns the pod of postgres that is labeled as primary, or "" if there is none.
+func (c *Controller) primaryMember(postgres *api.Postgres) (string, error) {
+	pods, err := c.Client.CoreV1().Pods(postgres.Namespace).List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(postgres.OffshootSelectors()).String(),
+	})
+	if err != nil {
+		return "", err
+	}
+	for _, pod := range pods.Items {
+		if pod.Labels[NodeRole] == le.RolePrimary {
+			return pod.Name, nil
+		}
+	}
+	return "", nil
+}
+
+// isPrimaryReady returns true, if member runs as primary and is ready.
+func (c *Controller) isPrimaryReady(postgres *api.Postgres, member string) (bool, error) {
+	pod, err := c.Client.CoreV1().Pods(postgres.Namespace).Get(member, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return pod.Labels[NodeRole] == le.RolePrimary && le.IsPodReady(pod), nil
+}
+
+// statefulSetVersion returns the PostgresVersion that the data directories of statefulSet belong to.
+// StatefulSets created before it was recorded are matched by their image.
+func (c *Controller) statefulSetVersion(statefulSet *apps.StatefulSet) (string, error) {
+	if version, found := statefulSet.Annotations[AnnotationPostgresVersion]; found {
+		return version, nil
+	}
+	var image string
+	for _, container := range statefulSet.Spec.Template.Spec.Containers {
+		if container.Name == api.ResourceSingularPostgres {
+			image = container.Image
+		}
+	}
+	versions, err := c.ExtClient.CatalogV1alpha1().PostgresVersions().List(metav1.ListOptions{})
+	if err != nil {
+		return "", err
+	}
+	for _, version := range versions.Items {
+		if version.Spec.DB.Image == image {
+			return version.Name, nil
+		}
+	}
+	return "", nil
+}
+
+func (c *Controller) setUpgradePhase(postgres *api.Postgres, phase api.UpgradePhase, reason string) error {
+	upgrade := postgres.Status.Upgrade.DeepCopy()
+	upgrade.Phase = phase
+	upgrade.Reason = reason
+	upgrade.LastTransitionTime = metav1.Now()
+	log.Infof("Upgrade of Postgres %s/%s: %s", postgres.Namespace, postgres.Name, reason)
+	return c.updateUpgrade(postgres, upgrade)
+}
+
+func (c *Controller) failUpgrade(postgres *api.Postgres, phase api.UpgradePhase, reason string) error {
+	c.recorder.Event(postgres, core.EventTypeWarning, EventReasonMajorVersionUpgradeFailed, reason)
+	return c.setUpgradePhase(postgres, phase, reason)
+}
+
+func (c *Controller) updateUpgrade(postgres *api.Postgres, upgrade *api.PostgresUpgradeStatus) error {
+	pg, err := util.UpdatePostgresStatus(c.ExtClient.KubedbV1alpha1(), postgres, func(in *api.PostgresStatus) *api.PostgresStatus {
+		in.Upgrade = upgrade
+		return in
+	})
+	if err != nil {
+		return err
+	}
+	postgres.Status = pg.Status
+	return nil
+}
+
+// syncUpgrades processes the Postgres objects with an upgrade in progress again, as the upgrade waits for
+// snapshots, jobs and pods that do not trigger their processing.
+func (c *Controller) syncUpgrades() {
+	dbs, err := c.pgLister.List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	for _, postgres := range dbs {
+		if upgrade := postgres.Status.Upgrade; upgrade != nil && upgradeInProgress(upgrade.Phase) {
+			queue.Enqueue(c.pgQueue.GetQueue(), postgres)
+		}
+	}
+}
+
+func upgradeInProgress(phase api.UpgradePhase) bool {
+	switch phase {
+	case api.UpgradePhaseSucceeded, api.UpgradePhaseRolledBack, api.UpgradePhaseFailed:
+		return false
+	}
+	return true
+}
+
+// isMajorVersionUpgrade returns true, if the data directories have to be upgraded from one version to the other.
+// It returns error, if there is no upgrade path between them.
+func isMajorVersionUpgrade(from, to *catalog.PostgresVersion) (bool, error) {
+	fromMajor, err := majorVersion(from.Spec.Version)
+	if err != nil {
+		return false, err
+	}
+	toMajor, err := majorVersion(to.Spec.Version)
+	if err != nil {
+		return false, err
+	}
+	for i := 0; i < len(fromMajor) || i < len(toMajor); i++ {
+		var f, t int
+		if i < len(fromMajor) {
+			f = fromMajor[i]
+		}
+		if i < len(toMajor) {
+			t = toMajor[i]
+		}
+		switch {
+		case f < t:
+			if to.Spec.Deprecated {
+				return false, fmt.Errorf("PostgresVersion %s is deprecated", to.Name)
+			}
+			return true, nil
+		case f > t:
+			return false, fmt.Errorf("downgrade from major version %s to %s is not supported", from.Spec.Version, to.Spec.Version)
+		}
+	}
+	return false, nil
+}
+
+// majorVersion returns the components of a postgres version that make up its major version,
+// ie. 9.6 for 9.6.7, but 10 for 10.2.
+func majorVersion(version string) ([]int, error) {
+	var major []int
+	for i, part := range strings.Split(version, ".") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid postgres version %q", version)
+		}
+		major = append(major, n)
+		if n >= 10 || i == 1 {
+			break
+		}
+	}
+	return major, nil
+}
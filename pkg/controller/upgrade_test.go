@@ -0,0 +1,387 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	catalog "kubedb.dev/apimachinery/apis/catalog/v1alpha1"
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	"kubedb.dev/apimachinery/client/clientset/versioned/fake"
+	amc "kubedb.dev/apimachinery/pkg/controller"
+	le "kubedb.dev/postgres/pkg/leader_election"
+
+	"github.com/appscode/go/encoding/json/types"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+)
+
+// newTestController returns a Controller with fake clients, that serve kubeObjects and extObjects.
+func newTestController(kubeObjects []runtime.Object, extObjects ...runtime.Object) *Controller {
+	return &Controller{
+		Controller: &amc.Controller{
+			Client:    kfake.NewSimpleClientset(kubeObjects...),
+			ExtClient: fake.NewSimpleClientset(extObjects...),
+		},
+		recorder: record.NewFakeRecorder(100),
+	}
+}
+
+// newMemberPod returns a ready pod of member ordinal of postgres, labeled with role.
+func newMemberPod(postgres *api.Postgres, ordinal int, role string) *core.Pod {
+	labels := postgres.OffshootSelectors()
+	labels[NodeRole] = role
+	return &core.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      postgres.OffshootName() + "-" + string(rune('0'+ordinal)),
+			Namespace: postgres.Namespace,
+			Labels:    labels,
+		},
+		Status: core.PodStatus{
+			Phase:      core.PodRunning,
+			Conditions: []core.PodCondition{{Type: core.PodReady, Status: core.ConditionTrue}},
+		},
+	}
+}
+
+func newPostgresVersion(name, version string, deprecated bool) *catalog.PostgresVersion {
+	return &catalog.PostgresVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: catalog.PostgresVersionSpec{
+			Version:    version,
+			Deprecated: deprecated,
+		},
+	}
+}
+
+func TestMajorVersion(t *testing.T) {
+	cases := []struct {
+		version string
+		want    []int
+		wantErr bool
+	}{
+		{"9.6.7", []int{9, 6}, false},
+		{"9.6", []int{9, 6}, false},
+		{"9", []int{9}, false},
+		{"10.2", []int{10}, false},
+		{"11", []int{11}, false},
+		{"11.1.0", []int{11}, false},
+		{"10-alpine", nil, true},
+		{"9.x", nil, true},
+		{"", nil, true},
+	}
+	for _, c := range cases {
+		t.Run(c.version, func(t *testing.T) {
+			got, err := majorVersion(c.version)
+			if (err != nil) != c.wantErr {
+				t.Fatalf("majorVersion() error = %v, wantErr %v", err, c.wantErr)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("majorVersion() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestIsMajorVersionUpgrade(t *testing.T) {
+	cases := []struct {
+		name       string
+		from, to   string
+		deprecated bool
+		want       bool
+		wantErr    bool
+	}{
+		{"same version", "10.2", "10.2", false, false, false},
+		{"minor version", "10.2", "10.6", false, false, false},
+		{"minor version before 10", "9.6.2", "9.6.7", false, false, false},
+		{"major version before 10", "9.5", "9.6", false, true, false},
+		{"major version from 9.6 to 10", "9.6.7", "10.2", false, true, false},
+		{"major version after 10", "10.6", "11.1", false, true, false},
+		{"major version to deprecated", "10.6", "11.1", true, false, true},
+		{"minor version to deprecated", "10.2", "10.6", true, false, false},
+		{"major downgrade", "11.1", "10.6", false, false, true},
+		{"major downgrade before 10", "9.6", "9.5", false, false, true},
+		{"invalid version", "10.2", "latest", false, false, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := isMajorVersionUpgrade(newPostgresVersion("from", c.from, false), newPostgresVersion("to", c.to, c.deprecated))
+			if (err != nil) != c.wantErr {
+				t.Fatalf("isMajorVersionUpgrade() error = %v, wantErr %v", err, c.wantErr)
+			}
+			if got != c.want {
+				t.Errorf("isMajorVersionUpgrade() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestUpgradeInProgress(t *testing.T) {
+	cases := []struct {
+		phase api.UpgradePhase
+		want  bool
+	}{
+		{api.UpgradePhaseSnapshotting, true},
+		{api.UpgradePhaseSwitchingOver, true},
+		{api.UpgradePhaseStopping, true},
+		{api.UpgradePhaseUpgrading, true},
+		{api.UpgradePhaseRollingOut, true},
+		{api.UpgradePhaseRollingBack, true},
+		{api.UpgradePhaseSucceeded, false},
+		{api.UpgradePhaseRolledBack, false},
+		{api.UpgradePhaseFailed, false},
+	}
+	for _, c := range cases {
+		t.Run(string(c.phase), func(t *testing.T) {
+			if got := upgradeInProgress(c.phase); got != c.want {
+				t.Errorf("upgradeInProgress() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestContinueUpgrade(t *testing.T) {
+	transition := metav1.NewTime(time.Now().Add(-time.Minute))
+	newPostgres := func(phase api.UpgradePhase) *api.Postgres {
+		return &api.Postgres{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+			Spec: api.PostgresSpec{
+				Version:  "11.1",
+				Replicas: int32P(3),
+			},
+			Status: api.PostgresStatus{
+				Upgrade: &api.PostgresUpgradeStatus{
+					FromVersion:        "10.6",
+					ToVersion:          "11.1",
+					Primary:            "foo-0",
+					Phase:              phase,
+					LastTransitionTime: transition,
+				},
+			},
+		}
+	}
+	// scaled down already, so that it is not patched
+	statefulSet := &apps.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+		Spec:       apps.StatefulSetSpec{Replicas: int32P(0)},
+	}
+	snapshot := func(phase api.SnapshotPhase) *api.Snapshot {
+		return &api.Snapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo-upgrade", Namespace: "default"},
+			Status:     api.SnapshotStatus{Phase: phase},
+		}
+	}
+
+	cases := []struct {
+		name        string
+		postgres    func() *api.Postgres
+		kubeObjects func(postgres *api.Postgres) []runtime.Object
+		extObjects  []runtime.Object
+		want        api.UpgradePhase
+	}{
+		{
+			name:     "snapshotting without backup schedule",
+			postgres: func() *api.Postgres { return newPostgres(api.UpgradePhaseSnapshotting) },
+			want:     api.UpgradePhaseSwitchingOver,
+		},
+		{
+			name: "snapshotting until snapshot succeeds",
+			postgres: func() *api.Postgres {
+				postgres := newPostgres(api.UpgradePhaseSnapshotting)
+				postgres.Spec.BackupSchedule = &api.BackupScheduleSpec{}
+				postgres.Status.Upgrade.Snapshot = "foo-upgrade"
+				return postgres
+			},
+			extObjects: []runtime.Object{snapshot(api.SnapshotPhaseRunning)},
+			want:       api.UpgradePhaseSnapshotting,
+		},
+		{
+			name: "snapshot succeeded",
+			postgres: func() *api.Postgres {
+				postgres := newPostgres(api.UpgradePhaseSnapshotting)
+				postgres.Spec.BackupSchedule = &api.BackupScheduleSpec{}
+				postgres.Status.Upgrade.Snapshot = "foo-upgrade"
+				return postgres
+			},
+			extObjects: []runtime.Object{snapshot(api.SnapshotPhaseSucceeded)},
+			want:       api.UpgradePhaseSwitchingOver,
+		},
+		{
+			name: "snapshot failed",
+			postgres: func() *api.Postgres {
+				postgres := newPostgres(api.UpgradePhaseSnapshotting)
+				postgres.Spec.BackupSchedule = &api.BackupScheduleSpec{}
+				postgres.Status.Upgrade.Snapshot = "foo-upgrade"
+				return postgres
+			},
+			extObjects: []runtime.Object{snapshot(api.SnapshotPhaseFailed)},
+			want:       api.UpgradePhaseFailed,
+		},
+		{
+			name:     "switching over to the upgraded member",
+			postgres: func() *api.Postgres { return newPostgres(api.UpgradePhaseSwitchingOver) },
+			kubeObjects: func(postgres *api.Postgres) []runtime.Object {
+				return []runtime.Object{newMemberPod(postgres, 0, le.RoleReplica), newMemberPod(postgres, 1, le.RolePrimary)}
+			},
+			want: api.UpgradePhaseSwitchingOver,
+		},
+		{
+			name: "switchover failed",
+			postgres: func() *api.Postgres {
+				postgres := newPostgres(api.UpgradePhaseSwitchingOver)
+				postgres.Status.Switchover = &api.PostgresSwitchoverStatus{
+					Target:             "foo-0",
+					Phase:              api.SwitchoverPhaseFailed,
+					LastTransitionTime: metav1.Now(),
+				}
+				return postgres
+			},
+			kubeObjects: func(postgres *api.Postgres) []runtime.Object {
+				return []runtime.Object{newMemberPod(postgres, 0, le.RoleReplica), newMemberPod(postgres, 1, le.RolePrimary)}
+			},
+			want: api.UpgradePhaseFailed,
+		},
+		{
+			name:     "upgraded member is primary",
+			postgres: func() *api.Postgres { return newPostgres(api.UpgradePhaseSwitchingOver) },
+			kubeObjects: func(postgres *api.Postgres) []runtime.Object {
+				return []runtime.Object{newMemberPod(postgres, 0, le.RolePrimary), newMemberPod(postgres, 1, le.RoleReplica)}
+			},
+			want: api.UpgradePhaseStopping,
+		},
+		{
+			name:     "stopping until all members are gone",
+			postgres: func() *api.Postgres { return newPostgres(api.UpgradePhaseStopping) },
+			kubeObjects: func(postgres *api.Postgres) []runtime.Object {
+				return []runtime.Object{statefulSet.DeepCopy(), newMemberPod(postgres, 0, le.RolePrimary)}
+			},
+			want: api.UpgradePhaseStopping,
+		},
+		{
+			name:     "all members stopped",
+			postgres: func() *api.Postgres { return newPostgres(api.UpgradePhaseStopping) },
+			kubeObjects: func(postgres *api.Postgres) []runtime.Object {
+				return []runtime.Object{statefulSet.DeepCopy()}
+			},
+			want: api.UpgradePhaseUpgrading,
+		},
+		{
+			name: "rollout timed out",
+			postgres: func() *api.Postgres {
+				postgres := newPostgres(api.UpgradePhaseRollingOut)
+				postgres.Status.Upgrade.LastTransitionTime = metav1.NewTime(time.Now().Add(-upgradeRolloutTimeout - time.Minute))
+				return postgres
+			},
+			want: api.UpgradePhaseRollingBack,
+		},
+		{
+			name:     "succeeded",
+			postgres: func() *api.Postgres { return newPostgres(api.UpgradePhaseSucceeded) },
+			want:     api.UpgradePhaseSucceeded,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			postgres := c.postgres()
+			var kubeObjects []runtime.Object
+			if c.kubeObjects != nil {
+				kubeObjects = c.kubeObjects(postgres)
+			}
+			ctrl := newTestController(kubeObjects, append(c.extObjects, postgres)...)
+			if err := ctrl.continueUpgrade(postgres); err != nil {
+				t.Fatal(err)
+			}
+			if postgres.Status.Upgrade.Phase != c.want {
+				t.Errorf("expected upgrade phase %s, got %s (%s)", c.want, postgres.Status.Upgrade.Phase, postgres.Status.Upgrade.Reason)
+			}
+		})
+	}
+}
+
+func TestEnsureMajorVersionUpgrade(t *testing.T) {
+	newPostgres := func(version string, upgrade *api.PostgresUpgradeStatus) *api.Postgres {
+		return &api.Postgres{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+			Spec: api.PostgresSpec{
+				Version:     types.StrYo(version),
+				Replicas:    int32P(3),
+				StorageType: api.StorageTypeDurable,
+			},
+			Status: api.PostgresStatus{Upgrade: upgrade},
+		}
+	}
+	statefulSet := &apps.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "foo",
+			Namespace:   "default",
+			Annotations: map[string]string{AnnotationPostgresVersion: "10.6"},
+		},
+	}
+	failed := &api.PostgresUpgradeStatus{FromVersion: "10.6", ToVersion: "11.1", Phase: api.UpgradePhaseFailed}
+
+	cases := []struct {
+		name        string
+		postgres    *api.Postgres
+		wantManaged bool
+		want        *api.UpgradePhase
+	}{
+		{"same version", newPostgres("10.6", nil), false, nil},
+		{"minor version", newPostgres("10.7", nil), false, nil},
+		{"major version", newPostgres("11.1", nil), true, upgradePhase(api.UpgradePhaseSwitchingOver)},
+		{"downgrade is rejected", newPostgres("9.6", nil), true, upgradePhase(api.UpgradePhaseFailed)},
+		{"failed upgrade is not retried", newPostgres("11.1", failed.DeepCopy()), true, upgradePhase(api.UpgradePhaseFailed)},
+		{"failed upgrade is forgotten once reverted", newPostgres("10.6", failed.DeepCopy()), false, nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctrl := newTestController([]runtime.Object{statefulSet.DeepCopy()},
+				c.postgres,
+				newPostgresVersion("9.6", "9.6.7", false),
+				newPostgresVersion("10.6", "10.6", false),
+				newPostgresVersion("10.7", "10.7", false),
+				newPostgresVersion("11.1", "11.1", false),
+			)
+			managed, err := ctrl.ensureMajorVersionUpgrade(c.postgres)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if managed != c.wantManaged {
+				t.Errorf("ensureMajorVersionUpgrade() = %v, want %v", managed, c.wantManaged)
+			}
+			var got *api.UpgradePhase
+			if c.postgres.Status.Upgrade != nil {
+				got = &c.postgres.Status.Upgrade.Phase
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("expected upgrade phase %v, got %v", c.want, got)
+			}
+		})
+	}
+}
+
+func upgradePhase(phase api.UpgradePhase) *api.UpgradePhase {
+	return &phase
+}
+
+func int32P(i int32) *int32 {
+	return &i
+}
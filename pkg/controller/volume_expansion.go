@@ -0,0 +1,217 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	"kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1/util"
+
+	"github.com/appscode/go/log"
+	"github.com/appscode/go/types"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+const (
+	EventReasonVolumeExpansion       = "VolumeExpansion"
+	EventReasonVolumeExpansionFailed = "VolumeExpansionFailed"
+
+	// period at which Postgres objects with a volume expansion in progress are processed again
+	volumeExpansionSyncPeriod = 10 * time.Second
+)
+
+// ensureVolumeExpansion expands the data volumes of postgres, when its storage request is increased.
+// The volume claims of the members are patched while the members keep running, and once every volume and
+// file system is resized, the StatefulSet is deleted without its pods, so that it is created again with the
+// increased storage request in its immutable volume claim templates. It returns true while the StatefulSet
+// is being deleted.
+func (c *Controller) ensureVolumeExpansion(postgres *api.Postgres) (bool, error) {
+	if postgres.Spec.StorageType == api.StorageTypeEphemeral || postgres.Spec.Storage == nil {
+		return false, nil
+	}
+	size, found := postgres.Spec.Storage.Resources.Requests[core.ResourceStorage]
+	if !found {
+		return false, nil
+	}
+
+	statefulSet, err := c.Client.AppsV1().StatefulSets(postgres.Namespace).Get(postgres.OffshootName(), metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if statefulSet.DeletionTimestamp != nil {
+		return true, nil
+	}
+	var claimSize resource.Quantity
+	for _, vc := range statefulSet.Spec.VolumeClaimTemplates {
+		if vc.Name == "data" {
+			claimSize = vc.Spec.Resources.Requests[core.ResourceStorage]
+		}
+	}
+
+	expansion := postgres.Status.VolumeExpansion
+	if size.Cmp(claimSize) <= 0 {
+		if expansion != nil && expansion.Phase == api.VolumeExpansionPhaseRecreating {
+			msg := fmt.Sprintf("data volumes are expanded to %s", size.String())
+			c.recorder.Event(postgres, core.EventTypeNormal, EventReasonVolumeExpansion, msg)
+			return false, c.updateVolumeExpansion(postgres, size, nil, api.VolumeExpansionPhaseSucceeded, msg)
+		}
+		return false, nil
+	}
+
+	var pending []string
+	var waiting []string
+	allowed := map[string]bool{}
+	for i := 0; i < int(types.Int32(statefulSet.Spec.Replicas)); i++ {
+		pvc, err := c.Client.CoreV1().PersistentVolumeClaims(postgres.Namespace).Get(fmt.Sprintf("data-%s-%d", statefulSet.Name, i), metav1.GetOptions{})
+		if kerr.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return false, err
+		}
+
+		className := types.String(pvc.Spec.StorageClassName)
+		if _, found := allowed[className]; !found && className != "" {
+			storageClass, err := c.Client.StorageV1beta1().StorageClasses().Get(className, metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			allowed[className] = storageClass.AllowVolumeExpansion != nil && *storageClass.AllowVolumeExpansion
+		}
+		if !allowed[className] {
+			msg := fmt.Sprintf("volume claim %s can not be expanded to %s, as its StorageClass %q does not allow volume expansion",
+				pvc.Name, size.String(), className)
+			if expansion == nil || expansion.Phase != api.VolumeExpansionPhaseFailed || expansion.Size.Cmp(size) != 0 {
+				c.recorder.Event(postgres, core.EventTypeWarning, EventReasonVolumeExpansionFailed, msg)
+				return false, c.updateVolumeExpansion(postgres, size, nil, api.VolumeExpansionPhaseFailed, msg)
+			}
+			return false, nil
+		}
+
+		request := pvc.Spec.Resources.Requests[core.ResourceStorage]
+		if request.Cmp(size) < 0 {
+			if pvc, err = c.expandPVC(pvc, size); err != nil {
+				return false, fmt.Errorf("failed to expand volume claim %s. Reason: %v", pvc.Name, err)
+			}
+		}
+
+		capacity := pvc.Status.Capacity[core.ResourceStorage]
+		resizing := ""
+		for _, cond := range pvc.Status.Conditions {
+			if (cond.Type == core.PersistentVolumeClaimResizing || cond.Type == core.PersistentVolumeClaimFileSystemResizePending) &&
+				cond.Status == core.ConditionTrue {
+				resizing = string(cond.Type)
+			}
+		}
+		if capacity.Cmp(size) < 0 || resizing != "" {
+			pending = append(pending, pvc.Name)
+			if resizing != "" {
+				waiting = append(waiting, fmt.Sprintf("%s is %s", pvc.Name, resizing))
+			}
+		}
+	}
+
+	if len(pending) > 0 {
+		msg := fmt.Sprintf("expanding data volumes to %s", size.String())
+		if len(waiting) > 0 {
+			msg = fmt.Sprintf("%s, %s", msg, strings.Join(waiting, ", "))
+		}
+		if expansion == nil || expansion.Size.Cmp(size) != 0 || expansion.Phase != api.VolumeExpansionPhaseExpanding {
+			c.recorder.Event(postgres, core.EventTypeNormal, EventReasonVolumeExpansion, msg)
+		} else if reflect.DeepEqual(expansion.PendingVolumes, pending) && expansion.Reason == msg {
+			return false, nil
+		}
+		return false, c.updateVolumeExpansion(postgres, size, pending, api.VolumeExpansionPhaseExpanding, msg)
+	}
+
+	// every volume is expanded, the pods are adopted by the StatefulSet created again
+	policy := metav1.DeletePropagationOrphan
+	if err := c.Client.AppsV1().StatefulSets(statefulSet.Namespace).Delete(statefulSet.Name, &metav1.DeleteOptions{
+		PropagationPolicy: &policy,
+	}); err != nil && !kerr.IsNotFound(err) {
+		return false, err
+	}
+	msg := fmt.Sprintf("recreating StatefulSet %s with storage request %s", statefulSet.Name, size.String())
+	c.recorder.Event(postgres, core.EventTypeNormal, EventReasonVolumeExpansion, msg)
+	return true, c.updateVolumeExpansion(postgres, size, nil, api.VolumeExpansionPhaseRecreating, msg)
+}
+
+// expandPVC patches only the storage request of pvc, as the rest of its spec is immutable once it is bound.
+func (c *Controller) expandPVC(pvc *core.PersistentVolumeClaim, size resource.Quantity) (*core.PersistentVolumeClaim, error) {
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"resources": map[string]interface{}{
+				"requests": map[string]interface{}{
+					string(core.ResourceStorage): size.String(),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return pvc, err
+	}
+	patched, err := c.Client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Patch(pvc.Name, ktypes.MergePatchType, patch)
+	if err != nil {
+		return pvc, err
+	}
+	return patched, nil
+}
+
+func (c *Controller) updateVolumeExpansion(postgres *api.Postgres, size resource.Quantity, pending []string, phase api.VolumeExpansionPhase, reason string) error {
+	pg, err := util.UpdatePostgresStatus(c.ExtClient.KubedbV1alpha1(), postgres, func(in *api.PostgresStatus) *api.PostgresStatus {
+		in.VolumeExpansion = &api.PostgresVolumeExpansionStatus{
+			Size:               size,
+			PendingVolumes:     pending,
+			Phase:              phase,
+			Reason:             reason,
+			LastTransitionTime: metav1.Now(),
+		}
+		return in
+	})
+	if err != nil {
+		return err
+	}
+	postgres.Status = pg.Status
+	return nil
+}
+
+// syncVolumeExpansions processes the Postgres objects with a volume expansion in progress again, as the
+// expansion waits for volume claims and the StatefulSet, that do not trigger the processing of their Postgres.
+func (c *Controller) syncVolumeExpansions() {
+	dbs, err := c.pgLister.List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	for _, postgres := range dbs {
+		if expansion := postgres.Status.VolumeExpansion; expansion != nil &&
+			(expansion.Phase == api.VolumeExpansionPhaseExpanding || expansion.Phase == api.VolumeExpansionPhaseRecreating) {
+			queue.Enqueue(c.pgQueue.GetQueue(), postgres)
+		}
+	}
+}
@@ -0,0 +1,307 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+
+	"github.com/appscode/go/types"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	storage "k8s.io/api/storage/v1beta1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+func storageRequest(size string) core.ResourceRequirements {
+	return core.ResourceRequirements{
+		Requests: core.ResourceList{core.ResourceStorage: resource.MustParse(size)},
+	}
+}
+
+// newDataPVC returns the data volume claim of member i of the StatefulSet foo.
+func newDataPVC(i int, class, request, capacity string, conditions ...core.PersistentVolumeClaimConditionType) *core.PersistentVolumeClaim {
+	pvc := &core.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("data-foo-%d", i), Namespace: "default"},
+		Spec: core.PersistentVolumeClaimSpec{
+			StorageClassName: types.StringP(class),
+			Resources:        storageRequest(request),
+		},
+		Status: core.PersistentVolumeClaimStatus{
+			Capacity: core.ResourceList{core.ResourceStorage: resource.MustParse(capacity)},
+		},
+	}
+	for _, cond := range conditions {
+		pvc.Status.Conditions = append(pvc.Status.Conditions, core.PersistentVolumeClaimCondition{
+			Type:   cond,
+			Status: core.ConditionTrue,
+		})
+	}
+	return pvc
+}
+
+func TestEnsureVolumeExpansion(t *testing.T) {
+	statefulSet := &apps.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+		Spec: apps.StatefulSetSpec{
+			Replicas: int32P(2),
+			VolumeClaimTemplates: []core.PersistentVolumeClaim{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "data"},
+					Spec:       core.PersistentVolumeClaimSpec{Resources: storageRequest("1Gi")},
+				},
+			},
+		},
+	}
+	expandable := &storage.StorageClass{
+		ObjectMeta:           metav1.ObjectMeta{Name: "expandable"},
+		AllowVolumeExpansion: types.BoolP(true),
+	}
+	fixed := &storage.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "fixed"},
+	}
+
+	cases := []struct {
+		name      string
+		size      string
+		expansion *api.PostgresVolumeExpansionStatus
+		pvcs      []*core.PersistentVolumeClaim
+		// the storage requests of the volume claims afterwards
+		requests     []string
+		events       int
+		recreating   bool
+		phase        api.VolumeExpansionPhase
+		pending      []string
+		statefulSet  bool
+		statusUpdate bool
+	}{
+		{
+			name:        "storage request unchanged",
+			size:        "1Gi",
+			pvcs:        []*core.PersistentVolumeClaim{newDataPVC(0, "expandable", "1Gi", "1Gi")},
+			requests:    []string{"1Gi"},
+			statefulSet: true,
+		},
+		{
+			name: "storage class does not allow expansion",
+			size: "2Gi",
+			pvcs: []*core.PersistentVolumeClaim{
+				newDataPVC(0, "fixed", "1Gi", "1Gi"),
+				newDataPVC(1, "fixed", "1Gi", "1Gi"),
+			},
+			requests:     []string{"1Gi", "1Gi"},
+			events:       1,
+			phase:        api.VolumeExpansionPhaseFailed,
+			statefulSet:  true,
+			statusUpdate: true,
+		},
+		{
+			name: "expansion failed before for the same size",
+			size: "2Gi",
+			expansion: &api.PostgresVolumeExpansionStatus{
+				Size:  resource.MustParse("2Gi"),
+				Phase: api.VolumeExpansionPhaseFailed,
+			},
+			pvcs:        []*core.PersistentVolumeClaim{newDataPVC(0, "fixed", "1Gi", "1Gi")},
+			requests:    []string{"1Gi"},
+			phase:       api.VolumeExpansionPhaseFailed,
+			statefulSet: true,
+		},
+		{
+			name: "volume claims are patched",
+			size: "2Gi",
+			pvcs: []*core.PersistentVolumeClaim{
+				newDataPVC(0, "expandable", "1Gi", "1Gi"),
+				newDataPVC(1, "expandable", "1Gi", "1Gi"),
+			},
+			requests:     []string{"2Gi", "2Gi"},
+			events:       1,
+			phase:        api.VolumeExpansionPhaseExpanding,
+			pending:      []string{"data-foo-0", "data-foo-1"},
+			statefulSet:  true,
+			statusUpdate: true,
+		},
+		{
+			name: "file system resize pending",
+			size: "2Gi",
+			expansion: &api.PostgresVolumeExpansionStatus{
+				Size:           resource.MustParse("2Gi"),
+				Phase:          api.VolumeExpansionPhaseExpanding,
+				PendingVolumes: []string{"data-foo-0", "data-foo-1"},
+				Reason:         "expanding data volumes to 2Gi",
+			},
+			pvcs: []*core.PersistentVolumeClaim{
+				newDataPVC(0, "expandable", "2Gi", "2Gi"),
+				newDataPVC(1, "expandable", "2Gi", "2Gi", core.PersistentVolumeClaimFileSystemResizePending),
+			},
+			requests:     []string{"2Gi", "2Gi"},
+			phase:        api.VolumeExpansionPhaseExpanding,
+			pending:      []string{"data-foo-1"},
+			statefulSet:  true,
+			statusUpdate: true,
+		},
+		{
+			name: "every volume is expanded",
+			size: "2Gi",
+			expansion: &api.PostgresVolumeExpansionStatus{
+				Size:           resource.MustParse("2Gi"),
+				Phase:          api.VolumeExpansionPhaseExpanding,
+				PendingVolumes: []string{"data-foo-1"},
+			},
+			pvcs: []*core.PersistentVolumeClaim{
+				newDataPVC(0, "expandable", "2Gi", "2Gi"),
+				newDataPVC(1, "expandable", "2Gi", "2Gi"),
+			},
+			requests:     []string{"2Gi", "2Gi"},
+			events:       1,
+			recreating:   true,
+			phase:        api.VolumeExpansionPhaseRecreating,
+			statusUpdate: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			postgres := &api.Postgres{
+				// events are recorded for postgres, which requires its kind
+				TypeMeta:   metav1.TypeMeta{Kind: api.ResourceKindPostgres, APIVersion: api.SchemeGroupVersion.String()},
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+				Spec: api.PostgresSpec{
+					StorageType: api.StorageTypeDurable,
+					Storage:     &core.PersistentVolumeClaimSpec{Resources: storageRequest(c.size)},
+				},
+				Status: api.PostgresStatus{VolumeExpansion: c.expansion},
+			}
+			kubeObjects := []runtime.Object{statefulSet.DeepCopy(), expandable, fixed}
+			for _, pvc := range c.pvcs {
+				kubeObjects = append(kubeObjects, pvc)
+			}
+			ctrl := newTestController(kubeObjects, postgres.DeepCopy())
+
+			recreating, err := ctrl.ensureVolumeExpansion(postgres)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if recreating != c.recreating {
+				t.Errorf("expected recreating %v, got %v", c.recreating, recreating)
+			}
+			if events := len(ctrl.recorder.(*record.FakeRecorder).Events); events != c.events {
+				t.Errorf("expected %d events, got %d", c.events, events)
+			}
+
+			for i, want := range c.requests {
+				pvc, err := ctrl.Client.CoreV1().PersistentVolumeClaims("default").Get(c.pvcs[i].Name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				request := pvc.Spec.Resources.Requests[core.ResourceStorage]
+				if request.Cmp(resource.MustParse(want)) != 0 {
+					t.Errorf("expected %s to request %s, got %s", pvc.Name, want, request.String())
+				}
+				if types.String(pvc.Spec.StorageClassName) != types.String(c.pvcs[i].Spec.StorageClassName) {
+					t.Errorf("expected the storage class of %s to be kept", pvc.Name)
+				}
+			}
+
+			_, err = ctrl.Client.AppsV1().StatefulSets("default").Get("foo", metav1.GetOptions{})
+			if c.statefulSet && err != nil {
+				t.Errorf("expected StatefulSet to be kept, got %v", err)
+			} else if !c.statefulSet && !kerr.IsNotFound(err) {
+				t.Errorf("expected StatefulSet to be deleted, got %v", err)
+			}
+
+			pg, err := ctrl.ExtClient.KubedbV1alpha1().Postgreses("default").Get("foo", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			expansion := pg.Status.VolumeExpansion
+			if !c.statusUpdate {
+				if !reflect.DeepEqual(expansion, c.expansion) {
+					t.Errorf("expected status %+v to be kept, got %+v", c.expansion, expansion)
+				}
+				return
+			}
+			if expansion == nil {
+				t.Fatalf("expected phase %s, got no status", c.phase)
+			}
+			if expansion.Phase != c.phase {
+				t.Errorf("expected phase %s, got %s", c.phase, expansion.Phase)
+			}
+			if expansion.Size.Cmp(resource.MustParse(c.size)) != 0 {
+				t.Errorf("expected size %s, got %s", c.size, expansion.Size.String())
+			}
+			if !reflect.DeepEqual(expansion.PendingVolumes, c.pending) {
+				t.Errorf("expected pending volumes %v, got %v", c.pending, expansion.PendingVolumes)
+			}
+			if !reflect.DeepEqual(postgres.Status, pg.Status) {
+				t.Errorf("expected the status of postgres to be updated")
+			}
+		})
+	}
+}
+
+func TestEnsureVolumeExpansionSucceeded(t *testing.T) {
+	postgres := &api.Postgres{
+		TypeMeta:   metav1.TypeMeta{Kind: api.ResourceKindPostgres, APIVersion: api.SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+		Spec: api.PostgresSpec{
+			StorageType: api.StorageTypeDurable,
+			Storage:     &core.PersistentVolumeClaimSpec{Resources: storageRequest("2Gi")},
+		},
+		Status: api.PostgresStatus{
+			VolumeExpansion: &api.PostgresVolumeExpansionStatus{
+				Size:  resource.MustParse("2Gi"),
+				Phase: api.VolumeExpansionPhaseRecreating,
+			},
+		},
+	}
+	// the StatefulSet is created again with the increased storage request
+	statefulSet := &apps.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+		Spec: apps.StatefulSetSpec{
+			Replicas: int32P(1),
+			VolumeClaimTemplates: []core.PersistentVolumeClaim{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "data"},
+					Spec:       core.PersistentVolumeClaimSpec{Resources: storageRequest("2Gi")},
+				},
+			},
+		},
+	}
+	ctrl := newTestController([]runtime.Object{statefulSet}, postgres.DeepCopy())
+
+	recreating, err := ctrl.ensureVolumeExpansion(postgres)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if recreating {
+		t.Errorf("expected the StatefulSet not to be recreated again")
+	}
+	pg, err := ctrl.ExtClient.KubedbV1alpha1().Postgreses("default").Get("foo", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if phase := pg.Status.VolumeExpansion.Phase; phase != api.VolumeExpansionPhaseSucceeded {
+		t.Errorf("expected phase %s, got %s", api.VolumeExpansionPhaseSucceeded, phase)
+	}
+}
@@ -24,6 +24,7 @@ import (
 
 	"gomodules.xyz/stow"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	store "kmodules.xyz/objectstore-api/api/v1"
 	"kmodules.xyz/objectstore-api/osm"
 )
 
@@ -61,20 +62,7 @@ func (c *Controller) wipeOutWalData(meta metav1.ObjectMeta, spec *api.PostgresSp
 		// Do not remove local Data.
 		return nil
 	}
-	cfg, err := osm.NewOSMContext(c.Client, *postgres.Spec.Archiver.Storage, postgres.Namespace)
-	if err != nil {
-		return err
-	}
-
-	loc, err := stow.Dial(cfg.Provider, cfg.Config)
-	if err != nil {
-		return err
-	}
-	bucket, err := postgres.Spec.Archiver.Storage.Container()
-	if err != nil {
-		return err
-	}
-	container, err := loc.Container(bucket)
+	container, err := c.archiveContainer(postgres)
 	if err != nil {
 		return err
 	}
@@ -99,3 +87,26 @@ func (c *Controller) wipeOutWalData(meta metav1.ObjectMeta, spec *api.PostgresSp
 
 	return nil
 }
+
+// archiveContainer returns the container of spec.archiver.storage of postgres, that the WAL archive is stored in.
+func (c *Controller) archiveContainer(postgres *api.Postgres) (stow.Container, error) {
+	return c.backendContainer(*postgres.Spec.Archiver.Storage, postgres.Namespace)
+}
+
+// backendContainer returns the bucket of backend, with the storage secret of backend in namespace.
+func (c *Controller) backendContainer(backend store.Backend, namespace string) (stow.Container, error) {
+	cfg, err := osm.NewOSMContext(c.Client, backend, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := stow.Dial(cfg.Provider, cfg.Config)
+	if err != nil {
+		return nil, err
+	}
+	bucket, err := backend.Container()
+	if err != nil {
+		return nil, err
+	}
+	return loc.Container(bucket)
+}
@@ -29,6 +29,21 @@ func (c *Controller) initWatcher() {
 	c.pgQueue = queue.New("Postgres", c.MaxNumRequeues, c.NumThreads, c.runPostgres)
 	c.pgLister = c.KubedbInformerFactory.Kubedb().V1alpha1().Postgreses().Lister()
 	c.pgInformer.AddEventHandler(queue.NewObservableUpdateHandler(c.pgQueue.GetQueue(), true))
+
+	c.pgDatabaseInformer = c.KubedbInformerFactory.Kubedb().V1alpha1().PostgresDatabases().Informer()
+	c.pgDatabaseQueue = queue.New("PostgresDatabase", c.MaxNumRequeues, c.NumThreads, c.runPostgresDatabase)
+	c.pgDatabaseLister = c.KubedbInformerFactory.Kubedb().V1alpha1().PostgresDatabases().Lister()
+	c.pgDatabaseInformer.AddEventHandler(queue.NewObservableUpdateHandler(c.pgDatabaseQueue.GetQueue(), true))
+
+	c.pgRoleInformer = c.KubedbInformerFactory.Kubedb().V1alpha1().PostgresRoles().Informer()
+	c.pgRoleQueue = queue.New("PostgresRole", c.MaxNumRequeues, c.NumThreads, c.runPostgresRole)
+	c.pgRoleLister = c.KubedbInformerFactory.Kubedb().V1alpha1().PostgresRoles().Lister()
+	c.pgRoleInformer.AddEventHandler(queue.NewObservableUpdateHandler(c.pgRoleQueue.GetQueue(), true))
+
+	c.pbInformer = c.KubedbInformerFactory.Kubedb().V1alpha1().PgBouncers().Informer()
+	c.pbQueue = queue.New("PgBouncer", c.MaxNumRequeues, c.NumThreads, c.runPgBouncer)
+	c.pbLister = c.KubedbInformerFactory.Kubedb().V1alpha1().PgBouncers().Lister()
+	c.pbInformer.AddEventHandler(queue.NewObservableUpdateHandler(c.pbQueue.GetQueue(), true))
 }
 
 func (c *Controller) runPostgres(key string) error {
@@ -74,3 +89,126 @@ func (c *Controller) runPostgres(key string) error {
 	}
 	return nil
 }
+
+func (c *Controller) runPostgresDatabase(key string) error {
+	log.Debugln("started processing, key:", key)
+	obj, exists, err := c.pgDatabaseInformer.GetIndexer().GetByKey(key)
+	if err != nil {
+		log.Errorf("Fetching object with key %s from store failed with %v", key, err)
+		return err
+	}
+
+	if !exists {
+		log.Debugf("PostgresDatabase %s does not exist anymore", key)
+	} else {
+		pgdb := obj.(*api.PostgresDatabase).DeepCopy()
+		if pgdb.DeletionTimestamp != nil {
+			if core_util.HasFinalizer(pgdb.ObjectMeta, api.GenericKey) {
+				if err := c.dropPostgresDatabase(pgdb); err != nil {
+					log.Errorln(err)
+					return err
+				}
+				_, _, err = util.PatchPostgresDatabase(c.ExtClient.KubedbV1alpha1(), pgdb, func(in *api.PostgresDatabase) *api.PostgresDatabase {
+					in.ObjectMeta = core_util.RemoveFinalizer(in.ObjectMeta, api.GenericKey)
+					return in
+				})
+				return err
+			}
+		} else {
+			pgdb, _, err = util.PatchPostgresDatabase(c.ExtClient.KubedbV1alpha1(), pgdb, func(in *api.PostgresDatabase) *api.PostgresDatabase {
+				in.ObjectMeta = core_util.AddFinalizer(in.ObjectMeta, api.GenericKey)
+				return in
+			})
+			if err != nil {
+				return err
+			}
+			if err := c.ensurePostgresDatabase(pgdb); err != nil {
+				log.Errorln(err)
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Controller) runPostgresRole(key string) error {
+	log.Debugln("started processing, key:", key)
+	obj, exists, err := c.pgRoleInformer.GetIndexer().GetByKey(key)
+	if err != nil {
+		log.Errorf("Fetching object with key %s from store failed with %v", key, err)
+		return err
+	}
+
+	if !exists {
+		log.Debugf("PostgresRole %s does not exist anymore", key)
+	} else {
+		role := obj.(*api.PostgresRole).DeepCopy()
+		if role.DeletionTimestamp != nil {
+			if core_util.HasFinalizer(role.ObjectMeta, api.GenericKey) {
+				if err := c.dropPostgresRole(role); err != nil {
+					log.Errorln(err)
+					return err
+				}
+				_, _, err = util.PatchPostgresRole(c.ExtClient.KubedbV1alpha1(), role, func(in *api.PostgresRole) *api.PostgresRole {
+					in.ObjectMeta = core_util.RemoveFinalizer(in.ObjectMeta, api.GenericKey)
+					return in
+				})
+				return err
+			}
+		} else {
+			role, _, err = util.PatchPostgresRole(c.ExtClient.KubedbV1alpha1(), role, func(in *api.PostgresRole) *api.PostgresRole {
+				in.ObjectMeta = core_util.AddFinalizer(in.ObjectMeta, api.GenericKey)
+				return in
+			})
+			if err != nil {
+				return err
+			}
+			if err := c.ensurePostgresRole(role); err != nil {
+				log.Errorln(err)
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Controller) runPgBouncer(key string) error {
+	log.Debugln("started processing, key:", key)
+	obj, exists, err := c.pbInformer.GetIndexer().GetByKey(key)
+	if err != nil {
+		log.Errorf("Fetching object with key %s from store failed with %v", key, err)
+		return err
+	}
+
+	if !exists {
+		log.Debugf("PgBouncer %s does not exist anymore", key)
+	} else {
+		pgbouncer := obj.(*api.PgBouncer).DeepCopy()
+		if pgbouncer.DeletionTimestamp != nil {
+			if core_util.HasFinalizer(pgbouncer.ObjectMeta, api.GenericKey) {
+				if err := c.terminatePgBouncer(pgbouncer); err != nil {
+					log.Errorln(err)
+					return err
+				}
+				_, _, err = util.PatchPgBouncer(c.ExtClient.KubedbV1alpha1(), pgbouncer, func(in *api.PgBouncer) *api.PgBouncer {
+					in.ObjectMeta = core_util.RemoveFinalizer(in.ObjectMeta, api.GenericKey)
+					return in
+				})
+				return err
+			}
+		} else {
+			pgbouncer, _, err = util.PatchPgBouncer(c.ExtClient.KubedbV1alpha1(), pgbouncer, func(in *api.PgBouncer) *api.PgBouncer {
+				in.ObjectMeta = core_util.AddFinalizer(in.ObjectMeta, api.GenericKey)
+				return in
+			})
+			if err != nil {
+				return err
+			}
+			if err := c.ensurePgBouncer(pgbouncer); err != nil {
+				log.Errorln(err)
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	cs "kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1"
+	"kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1/util"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	EventReasonBaseBackupPushed = "BaseBackupPushed"
+	EventReasonBaseBackupFailed = "BaseBackupFailed"
+
+	// Script of the database image, that pushes a base backup with wal-g and lists the base backups in the archive
+	BaseBackupScript = "/scripts/base-backup.sh"
+)
+
+// baseBackup pushes the base backup, that the operator has scheduled on this member. It runs on every member,
+// as the operator prefers a replica to push it.
+type baseBackup struct {
+	dbClient  cs.KubedbV1alpha1Interface
+	namespace string
+	identity  string
+
+	// Postgres object that the base backups are scheduled on. nil if unknown.
+	postgres *core.ObjectReference
+	recorder record.EventRecorder
+
+	// push runs BaseBackupScript, and returns the names of the base backups in the archive
+	push func(retain int32) ([]string, error)
+}
+
+// Run pushes the scheduled base backups, one at a time.
+func (b *baseBackup) Run(interval time.Duration, stopCh <-chan struct{}) {
+	if b.postgres == nil {
+		return
+	}
+	if b.push == nil {
+		b.push = pushBaseBackup
+	}
+	wait.Until(b.sync, interval, stopCh)
+}
+
+func (b *baseBackup) sync() {
+	postgres, err := b.dbClient.Postgreses(b.namespace).Get(b.postgres.Name, metav1.GetOptions{})
+	if err != nil {
+		log.Println("failed to get Postgres:", err)
+		return
+	}
+	status := postgres.Status.BaseBackup
+	if status == nil || status.Member != b.identity {
+		return
+	}
+
+	switch status.Phase {
+	case api.BaseBackupPhaseRunning:
+		// base backups are pushed synchronously, so a running one has been interrupted by a restart of this pod
+		b.update(postgres, api.BaseBackupPhaseFailed, fmt.Sprintf("base backup on %s was interrupted", b.identity), nil)
+		return
+	case api.BaseBackupPhasePending:
+	default:
+		return
+	}
+
+	var retain int32
+	if archiver := postgres.Spec.Archiver; archiver != nil && archiver.BaseBackup != nil {
+		retain = archiver.BaseBackup.Retain
+	}
+	postgres = b.update(postgres, api.BaseBackupPhaseRunning, fmt.Sprintf("%s is pushing a base backup", b.identity), nil)
+	if postgres == nil {
+		return
+	}
+	backups, err := b.push(retain)
+	if err != nil {
+		b.update(postgres, api.BaseBackupPhaseFailed, fmt.Sprintf("failed to push base backup on %s. Reason: %v", b.identity, err), nil)
+		return
+	}
+	b.update(postgres, api.BaseBackupPhaseSucceeded, fmt.Sprintf("%s pushed a base backup", b.identity), backups)
+}
+
+// update records the progress of the base backup as event and status of the Postgres object. The updated Postgres
+// object is returned, or nil if the status could not be updated.
+func (b *baseBackup) update(postgres *api.Postgres, phase api.BaseBackupPhase, msg string, backups []string) *api.Postgres {
+	log.Println(msg)
+	switch phase {
+	case api.BaseBackupPhaseFailed:
+		b.recorder.Event(b.postgres, core.EventTypeWarning, EventReasonBaseBackupFailed, msg)
+	case api.BaseBackupPhaseSucceeded:
+		b.recorder.Event(b.postgres, core.EventTypeNormal, EventReasonBaseBackupPushed, msg)
+	}
+
+	pg, err := util.UpdatePostgresStatus(b.dbClient, postgres, func(in *api.PostgresStatus) *api.PostgresStatus {
+		if in.BaseBackup == nil {
+			in.BaseBackup = &api.PostgresBaseBackupStatus{}
+		}
+		now := metav1.Now()
+		in.BaseBackup.Phase = phase
+		in.BaseBackup.Reason = msg
+		in.BaseBackup.LastTransitionTime = now
+		if phase == api.BaseBackupPhaseSucceeded {
+			in.BaseBackup.LastBackupTime = &now
+			in.BaseBackup.Backups = backups
+		}
+		return in
+	})
+	if err != nil {
+		log.Println("failed to update base backup status:", err)
+		return nil
+	}
+	return pg
+}
+
+// pushBaseBackup runs BaseBackupScript as postgres, keeping retain full base backups.
+func pushBaseBackup(retain int32) ([]string, error) {
+	var stdout bytes.Buffer
+	cmd := exec.Command("su-exec", "postgres", BaseBackupScript, strconv.Itoa(int(retain)))
+	cmd.Env = os.Environ()
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, err
+	}
+	return parseBackupList(stdout.String()), nil
+}
+
+// parseBackupList returns the names of the base backups listed by wal-g backup-list, in the listed order.
+func parseBackupList(out string) []string {
+	var backups []string
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || !strings.HasPrefix(fields[0], "base_") {
+			// the header, or the notice that the archive has no base backups yet
+			continue
+		}
+		backups = append(backups, fields[0])
+	}
+	return backups
+}
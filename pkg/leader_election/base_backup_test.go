@@ -0,0 +1,109 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	extFake "kubedb.dev/apimachinery/client/clientset/versioned/fake"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestParseBackupList(t *testing.T) {
+	out := `name                          last_modified        wal_segment_backup_start
+base_000000010000000000000002 2019-11-04T10:15:02Z 000000010000000000000002
+base_000000010000000000000007 2019-11-05T10:15:03Z 000000010000000000000007
+`
+	want := []string{"base_000000010000000000000002", "base_000000010000000000000007"}
+	if got := parseBackupList(out); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if got := parseBackupList("No backups found\n"); got != nil {
+		t.Errorf("expected no backups, got %v", got)
+	}
+}
+
+func TestBaseBackupSync(t *testing.T) {
+	cases := []struct {
+		name    string
+		phase   api.BaseBackupPhase
+		member  string
+		pushErr error
+		want    api.BaseBackupPhase
+		pushed  bool
+	}{
+		{"pending on this member", api.BaseBackupPhasePending, "foo-1", nil, api.BaseBackupPhaseSucceeded, true},
+		{"pending on another member", api.BaseBackupPhasePending, "foo-0", nil, api.BaseBackupPhasePending, false},
+		{"push fails", api.BaseBackupPhasePending, "foo-1", errors.New("exit status 1"), api.BaseBackupPhaseFailed, true},
+		{"interrupted", api.BaseBackupPhaseRunning, "foo-1", nil, api.BaseBackupPhaseFailed, false},
+		{"succeeded", api.BaseBackupPhaseSucceeded, "foo-1", nil, api.BaseBackupPhaseSucceeded, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			postgres := &api.Postgres{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+				Spec: api.PostgresSpec{
+					Archiver: &api.PostgresArchiverSpec{
+						BaseBackup: &api.PostgresBaseBackupSpec{Schedule: "@daily", Retain: 3},
+					},
+				},
+				Status: api.PostgresStatus{
+					BaseBackup: &api.PostgresBaseBackupStatus{Phase: c.phase, Member: c.member},
+				},
+			}
+			dbClient := extFake.NewSimpleClientset(postgres).KubedbV1alpha1()
+
+			pushed := false
+			b := &baseBackup{
+				dbClient:  dbClient,
+				namespace: "default",
+				identity:  "foo-1",
+				postgres:  &core.ObjectReference{Name: "foo", Namespace: "default"},
+				recorder:  record.NewFakeRecorder(10),
+				push: func(retain int32) ([]string, error) {
+					pushed = true
+					if retain != 3 {
+						t.Errorf("expected to retain 3 base backups, got %d", retain)
+					}
+					return []string{"base_000000010000000000000002"}, c.pushErr
+				},
+			}
+			b.sync()
+
+			got, err := dbClient.Postgreses("default").Get("foo", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if pushed != c.pushed {
+				t.Errorf("expected pushed to be %v", c.pushed)
+			}
+			if got.Status.BaseBackup.Phase != c.want {
+				t.Errorf("expected phase %s, got %s", c.want, got.Status.BaseBackup.Phase)
+			}
+			if c.want == api.BaseBackupPhaseSucceeded && c.pushed {
+				if got.Status.BaseBackup.LastBackupTime == nil || len(got.Status.BaseBackup.Backups) != 1 {
+					t.Errorf("expected the base backup to be recorded, got %+v", got.Status.BaseBackup)
+				}
+			}
+		})
+	}
+}
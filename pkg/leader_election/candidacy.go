@@ -0,0 +1,357 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	cs "kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1"
+
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	EventReasonCandidacyAccepted = "CandidacyAccepted"
+	EventReasonCandidacyRefused  = "CandidacyRefused"
+	EventReasonSteppedDown       = "SteppedDown"
+)
+
+// candidacyLock wraps a leader election lock, so that this pod acquires leadership only if its local
+// postgres is healthy and no other healthy member is more than maxLag bytes of WAL ahead of it.
+// Renewals of a lock that is already held by this pod are blocked only after it has stepped down.
+type candidacyLock struct {
+	resourcelock.Interface
+
+	kubeClient kubernetes.Interface
+	dbClient   cs.KubedbV1alpha1Interface
+	namespace  string
+	selector   string
+	wal        *walTracker
+	health     *healthChecker
+	maxLag     int64
+
+	// duration for which the leadership is reserved for the target of a planned switchover
+	switchoverWindow time.Duration
+
+	// Postgres object to record the candidacy decisions on. nil if unknown.
+	postgres *core.ObjectReference
+	recorder record.EventRecorder
+
+	// last observed leader election record
+	observed     *resourcelock.LeaderElectionRecord
+	lastDecision string
+
+	// leader election record that was replaced when this pod acquired the leadership
+	previous *resourcelock.LeaderElectionRecord
+
+	// former primaries, that are fenced by the next leader
+	unfenced *unfencedPrimaries
+
+	// accumulates the time during which no leader holds the lock. nil if not tracked.
+	leaderless *leaderlessTracker
+
+	// serializes the use of the lock by the leader elector, StepDown and HandOver
+	mu          sync.Mutex
+	leading     bool
+	steppedDown bool
+}
+
+func (l *candidacyLock) Get() (*resourcelock.LeaderElectionRecord, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	ler, err := l.Interface.Get()
+	l.observed = nil
+	if err == nil {
+		observed := *ler
+		l.observed = &observed
+	}
+	if l.leaderless != nil && (err == nil || kerr.IsNotFound(err)) {
+		l.leaderless.Observe(l.observed, time.Now())
+	}
+	return ler, err
+}
+
+// Previous returns the leader election record that was replaced when this pod acquired the leadership,
+// or nil if there was none.
+func (l *candidacyLock) Previous() *resourcelock.LeaderElectionRecord {
+	return l.previous
+}
+
+// Reset prepares the lock for a new campaign, after the leadership has been lost or given up.
+func (l *candidacyLock) Reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.leading, l.steppedDown = false, false
+	l.lastDecision = ""
+	l.previous = nil
+}
+
+func (l *candidacyLock) Create(ler resourcelock.LeaderElectionRecord) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.checkCandidacy(); err != nil {
+		return err
+	}
+	if err := l.Interface.Create(ler); err != nil {
+		return err
+	}
+	l.leading = true
+	l.lastDecision = ""
+	l.previous = nil
+	return nil
+}
+
+func (l *candidacyLock) Update(ler resourcelock.LeaderElectionRecord) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.steppedDown {
+		return errors.New("stepped down from the leadership")
+	}
+	if l.leading && l.observed != nil && l.observed.HolderIdentity == l.Identity() {
+		return l.Interface.Update(ler)
+	}
+	if err := l.checkCandidacy(); err != nil {
+		return err
+	}
+	if err := l.recordUnfenced(); err != nil {
+		return err
+	}
+	if err := l.Interface.Update(ler); err != nil {
+		return err
+	}
+	// leadership is acquired, decisions of the next campaign are recorded afresh
+	l.leading = true
+	l.lastDecision = ""
+	l.previous = l.observed
+	return nil
+}
+
+// recordUnfenced records the holders of the observed leader election record as unfenced primaries, before this pod
+// replaces them. A record held by this pod is no exception, as postgres of this pod may still run as primary from its
+// last leadership. l.mu must be held.
+func (l *candidacyLock) recordUnfenced() error {
+	if l.observed == nil {
+		return nil
+	}
+	holders := leaseHolders(l.observed.HolderIdentity)
+	if len(holders) == 0 {
+		return nil
+	}
+	members := map[string]time.Time{}
+	for _, holder := range holders {
+		members[holder] = l.observed.RenewTime.Time
+	}
+	if err := l.unfenced.Add(members); err != nil {
+		return fmt.Errorf("failed to record previous primary %s as unfenced. Reason: %v", l.observed.HolderIdentity, err)
+	}
+	return l.refresh(l.observed)
+}
+
+// refresh gets the leader election record again, as the lock updates the object that it has got last, and its
+// annotations have changed since. It returns error, if the record is not observed anymore. l.mu must be held.
+func (l *candidacyLock) refresh(observed *resourcelock.LeaderElectionRecord) error {
+	ler, err := l.Interface.Get()
+	if err != nil {
+		return err
+	}
+	if ler.HolderIdentity != observed.HolderIdentity || !ler.RenewTime.Equal(&observed.RenewTime) {
+		return errors.New("leader election record has changed")
+	}
+	return nil
+}
+
+// checkCandidacy returns error if another ready member has published a WAL position that is more than
+// maxLag bytes ahead of the position of this pod, or if this pod is not a synchronous standby while one is alive.
+func (l *candidacyLock) checkCandidacy() error {
+	if l.steppedDown {
+		return errors.New("stepped down from the leadership")
+	}
+	if err := l.health.Live(); err != nil {
+		msg := fmt.Sprintf("%s refused to become primary, local postgres is unhealthy. Reason: %v", l.Identity(), err)
+		l.recordDecision(core.EventTypeWarning, EventReasonCandidacyRefused, msg)
+		return errors.New(msg)
+	}
+	postgres := l.getPostgres()
+	target, reserved := switchoverTarget(postgres, l.switchoverWindow)
+	if reserved && target != l.Identity() {
+		msg := fmt.Sprintf("%s refused to become primary, leadership is being handed over to %s", l.Identity(), target)
+		l.recordDecision(core.EventTypeNormal, EventReasonCandidacyRefused, msg)
+		return errors.New(msg)
+	}
+	if primary, reserved := upgradePrimary(postgres); reserved && primary != l.Identity() {
+		msg := fmt.Sprintf("%s refused to become primary, leadership is reserved for %s by the major version upgrade", l.Identity(), primary)
+		l.recordDecision(core.EventTypeNormal, EventReasonCandidacyRefused, msg)
+		return errors.New(msg)
+	}
+
+	own, known := l.wal.Position()
+	ownPosition := "unknown WAL position"
+	if known {
+		ownPosition = fmt.Sprintf("WAL position %s", formatLSN(own))
+	}
+
+	pods, err := l.kubeClient.CoreV1().Pods(l.namespace).List(metav1.ListOptions{
+		LabelSelector: l.selector,
+	})
+	if err != nil {
+		return err
+	}
+	synchronous, isSynchronous := map[string]bool{}, false
+	if postgres != nil {
+		for _, name := range postgres.Status.SynchronousStandbys {
+			synchronous[name] = true
+		}
+		// the target of a planned switchover is preferred over synchronous standbys
+		isSynchronous = synchronous[l.Identity()] || reserved
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Name == l.Identity() || !IsPodReady(pod) {
+			continue
+		}
+		lsn, ok := memberPosition(pod, l.wal.staleAfter)
+		if ok && synchronous[pod.Name] && !isSynchronous {
+			// a synchronous standby has confirmed every commit, so it is preferred as long as it is alive
+			msg := fmt.Sprintf("%s refused to become primary, as synchronous standby %s is alive", l.Identity(), pod.Name)
+			l.recordDecision(core.EventTypeNormal, EventReasonCandidacyRefused, msg)
+			return errors.New(msg)
+		}
+		if !ok || lsn <= own || lsn-own <= uint64(l.maxLag) {
+			continue
+		}
+		msg := fmt.Sprintf("%s at %s refused to become primary. Member %s is %d bytes ahead at WAL position %s, maximum allowed lag is %d bytes",
+			l.Identity(), ownPosition, pod.Name, lsn-own, formatLSN(lsn), l.maxLag)
+		l.recordDecision(core.EventTypeWarning, EventReasonCandidacyRefused, msg)
+		return errors.New(msg)
+	}
+
+	msg := fmt.Sprintf("%s at %s is eligible to become primary", l.Identity(), ownPosition)
+	l.recordDecision(core.EventTypeNormal, EventReasonCandidacyAccepted, msg)
+	return nil
+}
+
+// recordDecision logs the candidacy decision and records it as an event of the Postgres object,
+// unless the same decision was already made during this campaign.
+func (l *candidacyLock) recordDecision(eventType, reason, msg string) {
+	if reason == l.lastDecision {
+		return
+	}
+	l.lastDecision = reason
+	log.Println(msg)
+	if l.postgres != nil {
+		l.recorder.Event(l.postgres, eventType, reason, msg)
+	}
+}
+
+// getPostgres returns the Postgres object that this pod belongs to, or nil if it is unknown.
+func (l *candidacyLock) getPostgres() *api.Postgres {
+	if l.postgres == nil {
+		return nil
+	}
+	postgres, err := l.dbClient.Postgreses(l.namespace).Get(l.postgres.Name, metav1.GetOptions{})
+	if err != nil {
+		log.Println("failed to get Postgres:", err)
+		return nil
+	}
+	return postgres
+}
+
+// switchoverTarget returns the pod that the leadership is being handed over to by a planned switchover, if any.
+// A switchover reserves the leadership for its target only within window.
+func switchoverTarget(postgres *api.Postgres, window time.Duration) (string, bool) {
+	if postgres == nil {
+		return "", false
+	}
+	sw := postgres.Status.Switchover
+	if sw == nil || sw.Phase != api.SwitchoverPhaseRunning || time.Since(sw.LastTransitionTime.Time) > window {
+		return "", false
+	}
+	return sw.Target, true
+}
+
+// upgradePrimary returns the pod whose data directory has been upgraded by a major version upgrade, if any.
+// The other members lost their data directories, they are seeded again from it.
+func upgradePrimary(postgres *api.Postgres) (string, bool) {
+	if postgres == nil {
+		return "", false
+	}
+	upgrade := postgres.Status.Upgrade
+	if upgrade == nil || (upgrade.Phase != api.UpgradePhaseRollingOut && upgrade.Phase != api.UpgradePhaseRollingBack) {
+		return "", false
+	}
+	return upgrade.Primary, true
+}
+
+// StepDown gives up the leadership held by this pod, because of reason.
+func (l *candidacyLock) StepDown(reason error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	msg := fmt.Sprintf("%s stepped down from primary. Reason: %v", l.Identity(), reason)
+	log.Println(msg)
+	if l.postgres != nil {
+		l.recorder.Event(l.postgres, core.EventTypeWarning, EventReasonSteppedDown, msg)
+	}
+	return l.release()
+}
+
+// HandOver gives up the leadership held by this pod, so that it is acquired by target of a planned switchover.
+func (l *candidacyLock) HandOver(target string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	log.Printf("Handing over the leadership to %s\n", target)
+	return l.release()
+}
+
+// release records this pod as unfenced primary, so that the next leader fences it, and clears the holder of
+// the lease. So, the other candidates acquire it without waiting for it to expire. Later attempts of this pod
+// to renew or acquire it fail. l.mu must be held.
+func (l *candidacyLock) release() error {
+	l.leading, l.steppedDown = false, true
+
+	ler, err := l.Interface.Get()
+	if err != nil {
+		return err
+	}
+	if ler.HolderIdentity != l.Identity() {
+		return nil
+	}
+	// Postgres may have run as primary until now. If it can not be recorded, the lease is left to expire,
+	// so that the next leader records this pod from the holder.
+	if err := l.unfenced.Add(map[string]time.Time{l.Identity(): time.Now()}); err != nil {
+		return fmt.Errorf("failed to record %s as unfenced. Reason: %v", l.Identity(), err)
+	}
+	if err := l.refresh(ler); err != nil {
+		return err
+	}
+	ler.HolderIdentity = ""
+	return l.Interface.Update(*ler)
+}
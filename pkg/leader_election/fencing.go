@@ -0,0 +1,238 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	cs "kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1"
+	"kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1/util"
+
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	core_util "kmodules.xyz/client-go/core/v1"
+)
+
+const (
+	EventReasonFencingSucceeded = "FencingSucceeded"
+	EventReasonFencingFailed    = "FencingFailed"
+)
+
+// fencer makes sure that the members which held the leadership before this pod are stopped,
+// before this pod is promoted to primary.
+type fencer struct {
+	kubeClient kubernetes.Interface
+	dbClient   cs.KubedbV1alpha1Interface
+	namespace  string
+	identity   string
+	timeout    time.Duration
+
+	// former primaries, that are recorded by the leader election lock until they are fenced
+	unfenced *unfencedPrimaries
+	// runsPrimary reports whether postgres may still run as primary in this pod
+	runsPrimary func() bool
+
+	// Postgres object to record the outcome on. nil if unknown.
+	postgres *core.ObjectReference
+	recorder record.EventRecorder
+}
+
+// Fence fences the former primaries that are recorded as unfenced, and forgets them. It returns error
+// if any of them can not be confirmed as stopped through the API server within the timeout.
+func (f *fencer) Fence() error {
+	recorded, err := f.unfenced.Get()
+	if err != nil {
+		err = fmt.Errorf("failed to get the unfenced primaries. Reason: %v", err)
+		f.report(nil, err)
+		return err
+	}
+	if len(recorded) == 0 {
+		return nil
+	}
+	members := make([]string, 0, len(recorded))
+	for member := range recorded {
+		members = append(members, member)
+	}
+	sort.Strings(members)
+
+	log.Printf("Fencing previous primary %s\n", strings.Join(members, ","))
+	deadline := time.Now().Add(f.timeout)
+	fenced := map[string]time.Time{}
+	for _, member := range members {
+		if member == f.identity {
+			err = f.fenceSelf(deadline)
+		} else {
+			err = f.fenceMember(member, recorded[member], deadline)
+		}
+		if err != nil {
+			err = fmt.Errorf("failed to fence previous primary %s. Reason: %v", member, err)
+			break
+		}
+		fenced[member] = recorded[member]
+	}
+	if rerr := f.unfenced.Remove(fenced); rerr != nil {
+		// they are fenced again by the next leader
+		log.Println("failed to forget the fenced primaries:", rerr)
+	}
+	f.report(members, err)
+	return err
+}
+
+// fenceSelf waits until postgres of this pod does not run as primary anymore. The supervisor restarts it as
+// replica, once this pod has lost the leadership. Postgres that ran before this process started has stopped
+// along with the container of the former process.
+func (f *fencer) fenceSelf(deadline time.Time) error {
+	err := wait.PollImmediate(time.Second, time.Until(deadline), func() (bool, error) {
+		return !f.runsPrimary(), nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("postgres is still running as primary after %v", f.timeout)
+	}
+	return err
+}
+
+// fenceMember takes the pod of a member out of the primary service and waits until its postgres process
+// is confirmed to be stopped. If the member does not stop by itself within half of the remaining time,
+// its pod is deleted.
+func (f *fencer) fenceMember(name string, lastRenew, deadline time.Time) error {
+	pod, err := f.kubeClient.CoreV1().Pods(f.namespace).Get(name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	uid := pod.UID
+
+	if pod.Labels[api.LabelRole] != RoleReplica {
+		pod, _, err = core_util.PatchPod(f.kubeClient, pod, func(in *core.Pod) *core.Pod {
+			if in.Labels == nil {
+				in.Labels = map[string]string{}
+			}
+			in.Labels[api.LabelRole] = RoleReplica
+			return in
+		})
+		if kerr.IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+
+	stopped := func() (bool, error) {
+		pod, err := f.kubeClient.CoreV1().Pods(f.namespace).Get(name, metav1.GetOptions{})
+		if kerr.IsNotFound(err) {
+			return true, nil
+		} else if err != nil {
+			return false, nil
+		}
+		return isMemberStopped(pod, uid, lastRenew), nil
+	}
+
+	// A primary steps down by itself when it fails to renew the lease. So, give it a chance before deleting its pod
+	if err = wait.PollImmediate(time.Second, time.Until(deadline)/2, stopped); err != wait.ErrWaitTimeout {
+		return err
+	}
+
+	log.Printf("%s is still running, deleting its pod\n", name)
+	err = f.kubeClient.CoreV1().Pods(f.namespace).Delete(name, &metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &uid},
+	})
+	if err != nil && !kerr.IsNotFound(err) && !kerr.IsConflict(err) {
+		return err
+	}
+	if err = wait.PollImmediate(time.Second, time.Until(deadline), stopped); err == wait.ErrWaitTimeout {
+		return fmt.Errorf("postgres is still reported as running after %v", f.timeout)
+	}
+	return err
+}
+
+// report records the outcome of fencing as event and status of the Postgres object.
+func (f *fencer) report(members []string, fenceErr error) {
+	eventType, reason := core.EventTypeNormal, EventReasonFencingSucceeded
+	phase, msg := api.FencingPhaseSucceeded, fmt.Sprintf("%s fenced previous primary %s", f.identity, strings.Join(members, ","))
+	if fenceErr != nil {
+		eventType, reason = core.EventTypeWarning, EventReasonFencingFailed
+		phase, msg = api.FencingPhaseFailed, fmt.Sprintf("%s refused to become primary, %v", f.identity, fenceErr)
+	}
+	log.Println(msg)
+
+	if f.postgres == nil {
+		return
+	}
+	f.recorder.Event(f.postgres, eventType, reason, msg)
+
+	postgres, err := f.dbClient.Postgreses(f.namespace).Get(f.postgres.Name, metav1.GetOptions{})
+	if err != nil {
+		log.Println("failed to get Postgres:", err)
+		return
+	}
+	if _, err := util.UpdatePostgresStatus(f.dbClient, postgres, func(in *api.PostgresStatus) *api.PostgresStatus {
+		in.Fencing = &api.PostgresFencingStatus{
+			Leader:             f.identity,
+			Members:            members,
+			Phase:              phase,
+			Reason:             msg,
+			LastTransitionTime: metav1.Now(),
+		}
+		return in
+	}); err != nil {
+		log.Println("failed to update fencing status:", err)
+	}
+}
+
+// leaseHolders returns the members of holderIdentity. While the leader election lock is migrated,
+// holderIdentity may consist of several comma separated members.
+func leaseHolders(holderIdentity string) []string {
+	var members []string
+	for _, member := range strings.Split(holderIdentity, ",") {
+		if member != "" {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+// isMemberStopped returns true, if the kubelet or the leader election sidecar of pod has reported that
+// the postgres process that was running in pod when it last renewed the lease is not running anymore.
+func isMemberStopped(pod *core.Pod, uid types.UID, lastRenew time.Time) bool {
+	if pod.UID != uid || pod.Status.Phase == core.PodSucceeded || pod.Status.Phase == core.PodFailed {
+		return true
+	}
+	// postgres is restarted in place by the sidecar, when it switches to replica
+	if started, err := time.Parse(time.RFC3339Nano, pod.Annotations[AnnotationPostgresStarted]); err == nil && started.After(lastRenew) {
+		return true
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name != api.ResourceSingularPostgres {
+			continue
+		}
+		if status.State.Running == nil {
+			return true
+		}
+		return status.State.Running.StartedAt.Time.After(lastRenew)
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+func newMemberPod(name string, started time.Time) *core.Pod {
+	return &core.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID("uid-" + name),
+			Labels:    map[string]string{api.LabelRole: RolePrimary},
+		},
+		Status: core.PodStatus{
+			Phase: core.PodRunning,
+			ContainerStatuses: []core.ContainerStatus{
+				{
+					Name: api.ResourceSingularPostgres,
+					State: core.ContainerState{
+						Running: &core.ContainerStateRunning{StartedAt: metav1.NewTime(started)},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestLeaseHolders(t *testing.T) {
+	cases := []struct {
+		holder string
+		want   []string
+	}{
+		{"", nil},
+		{"foo-0", []string{"foo-0"}},
+		{"foo-0,foo-1", []string{"foo-0", "foo-1"}},
+		{",foo-1", []string{"foo-1"}},
+	}
+	for _, c := range cases {
+		if got := leaseHolders(c.holder); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("leaseHolders(%q) = %v, want %v", c.holder, got, c.want)
+		}
+	}
+}
+
+func TestIsMemberStopped(t *testing.T) {
+	lastRenew := time.Now()
+
+	pod := newMemberPod("foo-1", lastRenew.Add(-time.Minute))
+	if isMemberStopped(pod, pod.UID, lastRenew) {
+		t.Error("postgres started before the last renewal must not be considered as stopped")
+	}
+	if !isMemberStopped(pod, "another-uid", lastRenew) {
+		t.Error("replaced pod must be considered as stopped")
+	}
+
+	pod.Annotations = map[string]string{
+		AnnotationPostgresStarted: lastRenew.Add(time.Second).Format(time.RFC3339Nano),
+	}
+	if !isMemberStopped(pod, pod.UID, lastRenew) {
+		t.Error("postgres restarted in place after the last renewal must be considered as stopped")
+	}
+
+	pod = newMemberPod("foo-1", lastRenew.Add(time.Minute))
+	if !isMemberStopped(pod, pod.UID, lastRenew) {
+		t.Error("postgres restarted after the last renewal must be considered as stopped")
+	}
+
+	pod.Status.ContainerStatuses[0].State = core.ContainerState{Waiting: &core.ContainerStateWaiting{}}
+	if !isMemberStopped(pod, pod.UID, lastRenew) {
+		t.Error("postgres that is not running must be considered as stopped")
+	}
+}
+
+func TestFence(t *testing.T) {
+	lastRenew := time.Now()
+	pod := newMemberPod("foo-1", lastRenew.Add(time.Minute))
+	pod.Labels[api.LabelRole] = RoleReplica
+	client := fake.NewSimpleClientset(pod)
+	if err := newTestLock(t, client, resourcelock.ConfigMapsResourceLock, "foo-0").Create(newRecord("foo-0", lastRenew)); err != nil {
+		t.Fatal(err)
+	}
+	runsPrimary := false
+	f := &fencer{
+		kubeClient:  client,
+		namespace:   "default",
+		identity:    "foo-0",
+		timeout:     time.Second,
+		unfenced:    newUnfencedPrimaries(resourcelock.ConfigMapsResourceLock, "default", "foo", client),
+		runsPrimary: func() bool { return runsPrimary },
+	}
+	unfenced := func() map[string]time.Time {
+		members, err := f.unfenced.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return members
+	}
+
+	if err := f.Fence(); err != nil {
+		t.Errorf("expected nothing to fence without unfenced primaries, got %v", err)
+	}
+
+	if err := f.unfenced.Add(map[string]time.Time{"foo-1": lastRenew, "foo-2": lastRenew}); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Fence(); err != nil {
+		t.Errorf("expected foo-1 and foo-2 to be fenced, got %v", err)
+	}
+	if members := unfenced(); len(members) != 0 {
+		t.Errorf("expected fenced primaries to be forgotten, got %v", members)
+	}
+
+	// a record held by this pod is fenced as well
+	runsPrimary = true
+	if err := f.unfenced.Add(map[string]time.Time{"foo-0": lastRenew}); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Fence(); err == nil {
+		t.Error("expected this pod not to be fenced, while postgres runs as primary")
+	}
+	if _, found := unfenced()["foo-0"]; !found {
+		t.Error("expected this pod to stay unfenced")
+	}
+	runsPrimary = false
+	if err := f.Fence(); err != nil {
+		t.Errorf("expected this pod to be fenced, got %v", err)
+	}
+
+	pod.Status.ContainerStatuses[0].State.Running.StartedAt = metav1.NewTime(lastRenew.Add(-time.Minute))
+	if _, err := client.CoreV1().Pods("default").Update(pod); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.unfenced.Add(map[string]time.Time{"foo-1": lastRenew}); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Fence(); err != nil {
+		t.Errorf("expected deleted member to be fenced, got %v", err)
+	}
+	if members := unfenced(); len(members) != 0 {
+		t.Errorf("expected fenced primaries to be forgotten, got %v", members)
+	}
+}
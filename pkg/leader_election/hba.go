@@ -0,0 +1,146 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+)
+
+const (
+	// Directory that the client authentication rules of spec.clientAuthentication are mounted at, as HBAFile.
+	// Postgres uses the file instead of the pg_hba.conf in the data directory, if it exists.
+	HBADir  = "/etc/postgresql/hba"
+	HBAFile = "pg_hba.conf"
+
+	// directory that the default client authentication rules are written to, if none are mounted
+	hbaRunDir = "/tmp/hba"
+)
+
+// HBAConf returns the content of HBAFile for auth. The entries that the members need for replication and
+// the exporter come first, so that rules of the user can not lock out the operator. Remote connections are
+// authenticated with md5 passwords, unless there are rules of the user. These are an allow-list then, and
+// only the superuser may connect from anywhere besides them, unless auth.LocalSuperuser is set. If requireSSL
+// is set, remote connections without TLS only match rules of the user, which may only reject them.
+// The entries of the superuser cover its alternate login role as well, that it is rotated to.
+func HBAConf(auth *api.PostgresClientAuthenticationSpec, superuser string, requireSSL bool) string {
+	users := strings.Join(superusers(superuser), ",")
+	host := string(api.PostgresHBATypeHost)
+	replication := []string{host, "replication", users, "0.0.0.0/0", "md5"}
+	if requireSSL {
+		host = string(api.PostgresHBATypeHostSSL)
+		replication = []string{host, "replication", users, "0.0.0.0/0", "md5", "clientcert=1"}
+	}
+
+	var buf bytes.Buffer
+	line := func(fields ...string) {
+		buf.WriteString(strings.Join(fields, " "))
+		buf.WriteString("\n")
+	}
+	buf.WriteString("# generated by the operator from spec.clientAuthentication\n")
+	line("local", "all", "all", "trust")
+	line("host", "all", "all", "127.0.0.1/32", "trust")
+	line(replication...)
+	if auth == nil || len(auth.Rules) == 0 {
+		if auth != nil && auth.LocalSuperuser {
+			line(rejectSuperuser(superuser)...)
+		}
+		line(host, "all", "all", "0.0.0.0/0", "md5")
+		return buf.String()
+	}
+	if auth.LocalSuperuser {
+		line(rejectSuperuser(superuser)...)
+	} else {
+		line(host, "all", users, "0.0.0.0/0", "md5")
+	}
+	for _, rule := range auth.Rules {
+		fields := []string{string(rule.Type), rule.Database, rule.User}
+		if rule.Type != api.PostgresHBATypeLocal {
+			fields = append(fields, rule.Address)
+		}
+		fields = append(fields, rule.Method)
+		line(append(fields, rule.Options...)...)
+	}
+	return buf.String()
+}
+
+// rejectSuperuser returns the entry of HBAFile, that restricts logins of superuser and its alternate login
+// role to the members themselves.
+func rejectSuperuser(superuser string) []string {
+	return []string{"host", "all", strings.Join(superusers(superuser), ","), "0.0.0.0/0", "reject"}
+}
+
+// superuserLocal returns true, if the client authentication rules mounted by the operator restrict logins of
+// superuser to the members themselves.
+func superuserLocal(superuser string) bool {
+	data, err := ioutil.ReadFile(filepath.Join(HBADir, HBAFile))
+	if err != nil {
+		return false
+	}
+	return rejectsSuperuser(string(data), superuser)
+}
+
+// rejectsSuperuser returns true, if the content conf of HBAFile restricts logins of superuser to the members.
+func rejectsSuperuser(conf, superuser string) bool {
+	reject := strings.Join(rejectSuperuser(superuser), " ")
+	for _, line := range strings.Split(conf, "\n") {
+		if strings.Join(strings.Fields(line), " ") == reject {
+			return true
+		}
+	}
+	return false
+}
+
+// hbaMounted returns true, if the operator mounted client authentication rules in this pod.
+func hbaMounted() bool {
+	_, err := os.Stat(filepath.Join(HBADir, HBAFile))
+	return err == nil
+}
+
+// setupHBA makes postgres use the client authentication rules mounted by the operator, if any. They are
+// reloaded in place, when spec.clientAuthentication changes. Otherwise postgres uses the rules of HBAConf
+// without rules of the user, in place of the pg_hba.conf in the data directory, that only lets the postgres
+// role replicate. If TLS is required, setupTLS has set up such rules already.
+func setupHBA(uid, gid int) error {
+	hba := filepath.Join(HBADir, HBAFile)
+	if !hbaMounted() {
+		if os.Getenv(SSLModeEnv) == SSLModeRequired {
+			return nil
+		}
+		hba = filepath.Join(hbaRunDir, HBAFile)
+		if err := writeHBA(hba, HBAConf(nil, superuser(), false), uid, gid); err != nil {
+			return err
+		}
+	}
+	options := strings.TrimSpace(os.Getenv(PostgresOptionsEnv) + " -c hba_file=" + hba)
+	return os.Setenv(PostgresOptionsEnv, options)
+}
+
+// writeHBA writes the client authentication rules conf to path, owned by uid and gid and readable only by them.
+func writeHBA(path, conf string, uid, gid int) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(path, []byte(conf), 0600); err != nil {
+		return err
+	}
+	return os.Chown(path, uid, gid)
+}
@@ -0,0 +1,108 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"testing"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+)
+
+func TestHBAConf(t *testing.T) {
+	const header = "# generated by the operator from spec.clientAuthentication\n" +
+		"local all all trust\n" +
+		"host all all 127.0.0.1/32 trust\n"
+	cases := []struct {
+		name       string
+		auth       *api.PostgresClientAuthenticationSpec
+		requireSSL bool
+		want       string
+	}{
+		{
+			name: "default",
+			auth: nil,
+			want: header +
+				"host replication admin,admin_alt 0.0.0.0/0 md5\n" +
+				"host all all 0.0.0.0/0 md5\n",
+		},
+		{
+			name: "rules",
+			auth: &api.PostgresClientAuthenticationSpec{
+				Rules: []api.PostgresHBARule{
+					{Type: api.PostgresHBATypeLocal, Database: "all", User: "app", Method: "peer", Options: []string{"map=app"}},
+					{Type: api.PostgresHBATypeHost, Database: "app", User: "app", Address: "10.0.0.0/8", Method: "scram-sha-256"},
+					{Type: api.PostgresHBATypeHost, Database: "all", User: "all", Address: "all", Method: "reject"},
+				},
+			},
+			want: header +
+				"host replication admin,admin_alt 0.0.0.0/0 md5\n" +
+				"host all admin,admin_alt 0.0.0.0/0 md5\n" +
+				"local all app peer map=app\n" +
+				"host app app 10.0.0.0/8 scram-sha-256\n" +
+				"host all all all reject\n",
+		},
+		{
+			name: "local superuser without rules",
+			auth: &api.PostgresClientAuthenticationSpec{LocalSuperuser: true},
+			want: header +
+				"host replication admin,admin_alt 0.0.0.0/0 md5\n" +
+				"host all admin,admin_alt 0.0.0.0/0 reject\n" +
+				"host all all 0.0.0.0/0 md5\n",
+		},
+		{
+			name: "local superuser with tls required",
+			auth: &api.PostgresClientAuthenticationSpec{
+				LocalSuperuser: true,
+				Rules: []api.PostgresHBARule{
+					{Type: api.PostgresHBATypeHostSSL, Database: "all", User: "all", Address: "0.0.0.0/0", Method: "cert"},
+				},
+			},
+			requireSSL: true,
+			want: header +
+				"hostssl replication admin,admin_alt 0.0.0.0/0 md5 clientcert=1\n" +
+				"host all admin,admin_alt 0.0.0.0/0 reject\n" +
+				"hostssl all all 0.0.0.0/0 cert\n",
+		},
+	}
+	for _, c := range cases {
+		if got := HBAConf(c.auth, "admin", c.requireSSL); got != c.want {
+			t.Errorf("%s: expected\n%s\ngot\n%s", c.name, c.want, got)
+		}
+	}
+}
+
+func TestRejectsSuperuser(t *testing.T) {
+	auth := &api.PostgresClientAuthenticationSpec{LocalSuperuser: true}
+	cases := []struct {
+		name      string
+		conf      string
+		superuser string
+		want      bool
+	}{
+		{"local superuser", HBAConf(auth, "admin", false), "admin", true},
+		{"alternate superuser", HBAConf(auth, "admin", false), "admin_alt", true},
+		{"other superuser", HBAConf(auth, "admin", false), "postgres", false},
+		{"remote superuser", HBAConf(nil, "admin", false), "admin", false},
+		{"aligned by hand", "host   all   admin,admin_alt   0.0.0.0/0   reject\n", "admin", true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := rejectsSuperuser(c.conf, c.superuser); got != c.want {
+				t.Errorf("rejectsSuperuser() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	HealthServerPort = 8008
+	LivenessPath     = "/healthz"
+	ReadinessPath    = "/readyz"
+)
+
+// healthChecker periodically checks the postgres server running in this pod. A connection check is done
+// on every member, and a write probe is done on the primary in addition.
+type healthChecker struct {
+	db               *sql.DB
+	period           time.Duration
+	failureThreshold int
+
+	// onUnhealthy is called once per promotion, when the primary fails failureThreshold consecutive checks
+	// after it has passed the write probe
+	onUnhealthy func(err error)
+
+	mu       sync.RWMutex
+	primary  bool
+	started  bool
+	writable bool
+	failures int
+	lastErr  error
+	notified bool
+}
+
+func newHealthChecker(db *sql.DB, period time.Duration, failureThreshold int, onUnhealthy func(err error)) *healthChecker {
+	return &healthChecker{
+		db:               db,
+		period:           period,
+		failureThreshold: failureThreshold,
+		onUnhealthy:      onUnhealthy,
+	}
+}
+
+func (h *healthChecker) Run(stopCh <-chan struct{}) {
+	wait.Until(h.sync, h.period, stopCh)
+}
+
+// SetPrimary enables the write probe, once postgres of this pod is promoted to primary.
+func (h *healthChecker) SetPrimary(primary bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.primary, h.writable, h.notified = primary, false, false
+}
+
+func (h *healthChecker) sync() {
+	h.mu.RLock()
+	primary := h.primary
+	h.mu.RUnlock()
+
+	err := h.check(primary)
+
+	h.mu.Lock()
+	if err == nil {
+		h.started, h.failures, h.lastErr = true, 0, nil
+		h.writable = h.writable || primary
+		h.mu.Unlock()
+		return
+	}
+	h.failures++
+	h.lastErr = err
+	// a new primary may still be restoring or promoting, it is not failed until it has been writable
+	failed := primary && h.writable && h.failures >= h.failureThreshold && !h.notified
+	if failed {
+		h.notified = true
+	}
+	h.mu.Unlock()
+
+	log.Printf("Health check failed (%d/%d): %v\n", h.failures, h.failureThreshold, err)
+	if failed && h.onUnhealthy != nil {
+		h.onUnhealthy(err)
+	}
+}
+
+func (h *healthChecker) check(primary bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), h.period)
+	defer cancel()
+
+	if _, err := h.db.ExecContext(ctx, "SELECT 1"); err != nil {
+		// A replica that is not a hot standby does not accept connections, while it is streaming WAL
+		if e, ok := err.(*pq.Error); ok && e.Code.Name() == "cannot_connect_now" && !primary {
+			return nil
+		}
+		return fmt.Errorf("connection check failed. Reason: %v", err)
+	}
+	if !primary {
+		return nil
+	}
+
+	tx, err := h.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("write probe failed. Reason: %v", err)
+	}
+	defer tx.Rollback() // nolint:errcheck
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL statement_timeout = %d", h.period/time.Millisecond)); err != nil {
+		return fmt.Errorf("write probe failed. Reason: %v", err)
+	}
+	// Assigning a transaction id makes the commit write and flush WAL, without touching any table of the user
+	if _, err := tx.ExecContext(ctx, "SELECT txid_current()"); err != nil {
+		return fmt.Errorf("write probe failed. Reason: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("write probe failed. Reason: %v", err)
+	}
+	return nil
+}
+
+// Live returns error, if postgres has been running once and the last failureThreshold checks have failed since.
+// Failures before postgres has been seen running are ignored, as restoring or cloning data may take long.
+func (h *healthChecker) Live() error {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if h.started && h.failures >= h.failureThreshold {
+		return h.lastErr
+	}
+	return nil
+}
+
+// Ready returns error, if the last check has failed.
+func (h *healthChecker) Ready() error {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if !h.started {
+		return fmt.Errorf("postgres is not running yet")
+	}
+	return h.lastErr
+}
+
+// Serve exposes the result of health checks for the liveness and readiness probes of the postgres container,
+// and the leader election metrics.
+func (h *healthChecker) Serve() error {
+	handler := func(check func() error) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if err := check(); err != nil {
+				http.Error(w, err.Error(), http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte("ok"))
+		}
+	}
+	mux := http.NewServeMux()
+	mux.Handle(LivenessPath, handler(h.Live))
+	mux.Handle(ReadinessPath, handler(h.Ready))
+	mux.HandleFunc(MetricsPath, metricsHandler)
+	return http.ListenAndServe(fmt.Sprintf(":%d", HealthServerPort), mux)
+}
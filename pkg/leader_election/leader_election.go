@@ -20,7 +20,6 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"os/exec"
 	"os/signal"
 	"os/user"
 	"strconv"
@@ -28,16 +27,20 @@ import (
 	"syscall"
 	"time"
 
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	cs "kubedb.dev/apimachinery/client/clientset/versioned"
+	"kubedb.dev/apimachinery/pkg/eventer"
+
 	"github.com/appscode/go/ioutil"
+	apps "k8s.io/api/apps/v1"
 	core "k8s.io/api/core/v1"
-	kerr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/leaderelection"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
-	"k8s.io/client-go/tools/record"
 	core_util "kmodules.xyz/client-go/core/v1"
 	"kmodules.xyz/client-go/tools/clientcmd"
 )
@@ -49,16 +52,44 @@ const (
 	LeaseDurationEnv = "LEASE_DURATION"
 	RenewDeadlineEnv = "RENEW_DEADLINE"
 	RetryPeriodEnv   = "RETRY_PERIOD"
+	LockTypeEnv      = "LEADER_ELECTION_LOCK"
+
+	MaximumLagBeforeFailoverEnv    = "MAXIMUM_LAG_BEFORE_FAILOVER"
+	FencingTimeoutEnv              = "FENCING_TIMEOUT"
+	HealthCheckPeriodEnv           = "HEALTH_CHECK_PERIOD"
+	HealthCheckFailureThresholdEnv = "HEALTH_CHECK_FAILURE_THRESHOLD"
+
+	StreamingEnv                    = "STREAMING"
+	SynchronousStandbysEnv          = "SYNCHRONOUS_STANDBYS"
+	SynchronousReplicationPolicyEnv = "SYNCHRONOUS_REPLICATION_POLICY"
+
+	EventReasonLeadershipAcquired = "LeadershipAcquired"
+	EventReasonLeadershipLost     = "LeadershipLost"
+	EventReasonFailoverStarted    = "FailoverStarted"
+	EventReasonRoleChanged        = "RoleChanged"
+	EventReasonRelabelFailed      = "RelabelFailed"
 )
 
 func RunLeaderElection() {
-	namespace, leaseDuration, renewDeadline, retryPeriod := loadEnvVariables()
+	cfg := loadEnvVariables()
 
 	// Change owner of Postgres data directory
 	if err := setPermission(); err != nil {
 		log.Fatalln(err)
 	}
 
+	// Certificates are prepared once, a rotation restarts the pod
+	uid, gid, err := postgresUser()
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if err := setupTLS(uid, gid); err != nil {
+		log.Fatalln(err)
+	}
+	if err := setupHBA(uid, gid); err != nil {
+		log.Fatalln(err)
+	}
+
 	hostname, err := os.Hostname()
 	if err != nil {
 		log.Fatalln(err)
@@ -79,174 +110,410 @@ func RunLeaderElection() {
 	if err != nil {
 		log.Fatalln(err)
 	}
+	dbClient, err := cs.NewForConfig(config)
+	if err != nil {
+		log.Fatalln(err)
+	}
 
-	configMap := &core.ConfigMap{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      GetLeaderLockName(statefulSetName),
-			Namespace: namespace,
-		},
+	statefulSet, err := kubeClient.AppsV1().StatefulSets(cfg.namespace).Get(statefulSetName, metav1.GetOptions{})
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	recorder := eventer.NewEventRecorder(kubeClient, "Postgres leader election")
+	postgres := postgresReference(statefulSet)
+	event := func(eventType, reason, msg string) {
+		log.Println(msg)
+		if postgres != nil {
+			recorder.Event(postgres, eventType, reason, msg)
+		}
+	}
+
+	log.Printf("Using %s as leader election lock\n", cfg.lockType)
+	lock, err := newResourceLock(cfg.lockType, cfg.namespace, statefulSetName, kubeClient, resourcelock.ResourceLockConfig{
+		Identity:      hostname,
+		EventRecorder: recorder,
+	})
+	if err != nil {
+		log.Fatalln(err)
 	}
-	if _, err := kubeClient.CoreV1().ConfigMaps(namespace).Create(configMap); err != nil && !kerr.IsAlreadyExists(err) {
+
+	db, err := openLocalDB()
+	if err != nil {
 		log.Fatalln(err)
 	}
 
-	resLock := &resourcelock.ConfigMapLock{
-		ConfigMapMeta: configMap.ObjectMeta,
-		Client:        kubeClient.CoreV1(),
-		LockConfig: resourcelock.ResourceLockConfig{
-			Identity:      hostname,
-			EventRecorder: &record.FakeRecorder{},
-		},
+	// Former primaries are recorded with the lock, until a leader has fenced them
+	unfenced := newUnfencedPrimaries(cfg.lockType, cfg.namespace, statefulSetName, kubeClient)
+
+	// Publish the WAL position of this pod, and campaign only while no other member is too far ahead
+	wal := newWalTracker(kubeClient, db, cfg.namespace, hostname, time.Duration(cfg.leaseDuration)*time.Second/3, 2*time.Duration(cfg.leaseDuration)*time.Second)
+	go wal.Run(wait.NeverStop)
+
+	// Campaign only while local postgres is healthy, and give up the leadership once it fails as primary
+	health := newHealthChecker(db, time.Duration(cfg.healthCheckPeriod)*time.Second, cfg.healthCheckFailureThreshold, nil)
+
+	resLock := &candidacyLock{
+		Interface:        lock,
+		kubeClient:       kubeClient,
+		dbClient:         dbClient.KubedbV1alpha1(),
+		namespace:        cfg.namespace,
+		selector:         metav1.FormatLabelSelector(statefulSet.Spec.Selector),
+		wal:              wal,
+		health:           health,
+		maxLag:           cfg.maxLag,
+		switchoverWindow: 2 * time.Duration(cfg.leaseDuration) * time.Second,
+		postgres:         postgres,
+		recorder:         recorder,
+		leaderless:       &leaderlessTracker{counter: leaderlessSeconds},
+		unfenced:         unfenced,
 	}
 
-	runningFirstTime := true
-	var cmd *exec.Cmd
-	lastLeader := ""
+	// The previous primary must be confirmed as stopped, before this pod is promoted
+	fence := &fencer{
+		kubeClient: kubeClient,
+		dbClient:   dbClient.KubedbV1alpha1(),
+		namespace:  cfg.namespace,
+		identity:   hostname,
+		timeout:    time.Duration(cfg.fencingTimeout) * time.Second,
+		unfenced:   unfenced,
+		postgres:   postgres,
+		recorder:   recorder,
+	}
 
-	runWrapperUntilExit := func(role string) {
-		log.Printf("Starting database wrapper script as %s\n", role)
-		// su-exec postgres /scripts/primary/run.sh
-		cmd = exec.Command("su-exec", "postgres", fmt.Sprintf("/scripts/%s/run.sh", role))
-		cmd.Stdout = os.Stdout
-		cmd.Stderr = os.Stderr
-		err = cmd.Run()
-		log.Println("DB Wrapper terminated, exiting too. If there was an error, find it below.")
-		cmd = nil
-		if err != nil {
-			log.Println(err)
-			os.Exit(1)
+	// Planned switchovers are handed over by the primary, and completed by the target once it is promoted
+	sw := &switchover{
+		kubeClient: kubeClient,
+		dbClient:   dbClient.KubedbV1alpha1(),
+		db:         db,
+		namespace:  cfg.namespace,
+		identity:   hostname,
+		lock:       resLock,
+		health:     health,
+		postgres:   postgres,
+		recorder:   recorder,
+	}
+
+	// The primary waits for commits to be confirmed by the replicas that are healthy and caught up
+	syncRep := &synchronousReplication{
+		kubeClient: kubeClient,
+		dbClient:   dbClient.KubedbV1alpha1(),
+		db:         db,
+		namespace:  cfg.namespace,
+		identity:   hostname,
+		selector:   metav1.FormatLabelSelector(statefulSet.Spec.Selector),
+		enabled:    cfg.synchronous,
+		standbys:   cfg.synchronousStandbys,
+		policy:     cfg.synchronousPolicy,
+		maxLag:     cfg.maxLag,
+		postgres:   postgres,
+		recorder:   recorder,
+	}
+
+	// The primary sets the password of the superuser, when the operator rotates it
+	rotation := &passwordRotation{
+		kubeClient: kubeClient,
+		dbClient:   dbClient.KubedbV1alpha1(),
+		db:         db,
+		namespace:  cfg.namespace,
+		identity:   hostname,
+		postgres:   postgres,
+		recorder:   recorder,
+	}
+
+	// Every member reloads its configuration, when the operator changes spec.parameters or spec.clientAuthentication
+	reloader := newConfigReloader(db, hostname, postgres, recorder)
+
+	// Every member pushes the base backups, that the operator schedules on it
+	backup := &baseBackup{
+		dbClient:  dbClient.KubedbV1alpha1(),
+		namespace: cfg.namespace,
+		identity:  hostname,
+		postgres:  postgres,
+		recorder:  recorder,
+	}
+
+	// Postgres is run and restarted in-process, so that the role of this pod can change without restarting it
+	sup := newSupervisor(kubeClient, cfg.namespace, hostname, postgres, recorder)
+	fence.runsPrimary = sup.RunsPrimary
+
+	// A former primary rejoins the new primary with pg_rewind, before it is started as replica
+	rejoin := &rejoiner{
+		dataDir:     os.Getenv("PGDATA"),
+		primaryHost: os.Getenv("PRIMARY_HOST"),
+		identity:    hostname,
+		postgres:    postgres,
+		recorder:    recorder,
+	}
+	sup.prepare = func(role string) []string {
+		if role != RoleReplica {
+			return nil
+		}
+		if method := rejoin.Rejoin(); method != "" {
+			return []string{RejoinMethodEnv + "=" + method}
 		}
-		os.Exit(0)
-	}
-	terminateDB := func() {
-		log.Println("Terminating postgres server and operator")
-		if cmd != nil && cmd.Process != nil {
-			log.Println("Postgres is running, sending SIGTERM")
-			utilruntime.Must(cmd.Process.Signal(syscall.SIGTERM))
-			log.Println("Waiting for postgres to terminate")
-			select {}
-		} else {
-			log.Println("Postgres already exited, nothing to do")
+		return nil
+	}
+	lastLeader := ""
+
+	health.onUnhealthy = func(err error) {
+		if err := resLock.StepDown(err); err != nil {
+			log.Println("Failed to release the leadership:", err)
 		}
+		health.SetPrimary(false)
+		sup.SwitchRole(RoleReplica)
+	}
+	sw.onHandOver = func() {
+		sup.SwitchRole(RoleReplica)
 	}
+	go health.Run(wait.NeverStop)
+	go reloader.Run(time.Duration(cfg.retryPeriod)*time.Second, wait.NeverStop)
+	go backup.Run(time.Duration(cfg.retryPeriod)*time.Second, wait.NeverStop)
+	go func() {
+		utilruntime.Must(health.Serve())
+	}()
 
 	go func() {
-		leaderelection.RunOrDie(context.Background(), leaderelection.LeaderElectionConfig{
-			Lock: resLock,
-			// ref: https://github.com/kubernetes/apiserver/blob/kubernetes-1.12.0/pkg/apis/config/v1alpha1/defaults.go#L26-L52
-			LeaseDuration: time.Duration(leaseDuration) * time.Second,
-			RenewDeadline: time.Duration(renewDeadline) * time.Second,
-			RetryPeriod:   time.Duration(retryPeriod) * time.Second,
-			Callbacks: leaderelection.LeaderCallbacks{
-				OnStartedLeading: func(ctx context.Context) {
-					log.Println("Got leadership, creating the trigger file")
-					if !ioutil.WriteString("/tmp/pg-failover-trigger", "") {
-						log.Fatalln("Failed to create trigger file")
-					}
-				},
-				OnStoppedLeading: func() {
-					log.Println("Lost leadership, initiating a restart to correctly signal the database")
-					terminateDB()
-				},
-				OnNewLeader: func(identity string) {
-					log.Printf("Leader changed from '%s' to '%s'\n", lastLeader, identity)
-					lastLeader = identity
-					statefulSet, err := kubeClient.AppsV1().StatefulSets(namespace).Get(statefulSetName, metav1.GetOptions{})
-					if err != nil {
-						log.Fatalln(err)
-					}
-
-					pods, err := kubeClient.CoreV1().Pods(namespace).List(metav1.ListOptions{
-						LabelSelector: metav1.FormatLabelSelector(statefulSet.Spec.Selector),
-					})
-					if err != nil {
-						log.Fatalln(err)
-					}
-
-					log.Println("Annotating pods for statefulset")
-					for _, pod := range pods.Items {
-						role := RoleReplica
-						if pod.Name == identity {
-							role = RolePrimary
+		for {
+			resLock.Reset()
+			leaderelection.RunOrDie(context.Background(), leaderelection.LeaderElectionConfig{
+				Lock: resLock,
+				// ref: https://github.com/kubernetes/apiserver/blob/kubernetes-1.12.0/pkg/apis/config/v1alpha1/defaults.go#L26-L52
+				LeaseDuration: time.Duration(cfg.leaseDuration) * time.Second,
+				RenewDeadline: time.Duration(cfg.renewDeadline) * time.Second,
+				RetryPeriod:   time.Duration(cfg.retryPeriod) * time.Second,
+				Callbacks: leaderelection.LeaderCallbacks{
+					OnStartedLeading: func(ctx context.Context) {
+						leadershipTransitions.WithLabelValues(transitionAcquired).Inc()
+						// a primary that steps down clears the holder of the lease
+						if previous := resLock.Previous(); previous != nil && previous.HolderIdentity != hostname &&
+							!isSwitchoverTarget(resLock.getPostgres(), hostname) {
+							from := previous.HolderIdentity
+							if from == "" {
+								from = "the primary that stepped down"
+							}
+							failovers.Inc()
+							event(core.EventTypeWarning, EventReasonFailoverStarted,
+								fmt.Sprintf("%s is taking over as primary from %s", hostname, from))
 						}
-						if _, _, err := core_util.PatchPod(kubeClient, &pod, func(in *core.Pod) *core.Pod {
-							in.Labels["kubedb.com/role"] = role
-							return in
-						}); err != nil {
-							// not sure if panic-ing will make the situation better or worse. but, as we are going to
-							// reimplement the postgres clustering part, lets keep it as it is right now
-							fmt.Println("got error while updating pod label:", err)
+						if err := fence.Fence(); err != nil {
+							log.Println("Giving up the leadership, as previous primary could not be fenced")
+							if err := resLock.StepDown(err); err != nil {
+								log.Println("Failed to release the leadership:", err)
+							}
+							sw.Complete(err)
+							sup.Start(RoleReplica)
+							return
 						}
 
-					}
+						log.Println("Got leadership, creating the trigger file")
+						if !ioutil.WriteString(FailoverTriggerFile, "") {
+							log.Fatalln("Failed to create trigger file")
+						}
+						event(core.EventTypeNormal, EventReasonLeadershipAcquired, fmt.Sprintf("%s acquired the leadership and is promoted to primary", hostname))
+						health.SetPrimary(true)
+						sup.Start(RolePrimary)
+						sw.Complete(nil)
+						go sw.Run(time.Duration(cfg.retryPeriod)*time.Second, ctx.Done())
+						go syncRep.Run(time.Duration(cfg.retryPeriod)*time.Second, ctx.Done())
+						go rotation.Run(time.Duration(cfg.retryPeriod)*time.Second, ctx.Done())
+					},
+					OnStoppedLeading: func() {
+						leadershipTransitions.WithLabelValues(transitionLost).Inc()
+						event(core.EventTypeWarning, EventReasonLeadershipLost, fmt.Sprintf("%s lost the leadership, restarting postgres as replica", hostname))
+						health.SetPrimary(false)
+						sup.SwitchRole(RoleReplica)
+					},
+					OnNewLeader: func(identity string) {
+						log.Printf("Leader changed from '%s' to '%s'\n", lastLeader, identity)
+						lastLeader = identity
+						leaderChanges.Inc()
+						statefulSet, err := kubeClient.AppsV1().StatefulSets(cfg.namespace).Get(statefulSetName, metav1.GetOptions{})
+						if err != nil {
+							log.Fatalln(err)
+						}
 
-					role := RoleReplica
-					if identity == hostname {
-						role = RolePrimary
-					}
+						pods, err := kubeClient.CoreV1().Pods(cfg.namespace).List(metav1.ListOptions{
+							LabelSelector: metav1.FormatLabelSelector(statefulSet.Spec.Selector),
+						})
+						if err != nil {
+							log.Fatalln(err)
+						}
 
-					log.Printf("This pod is now a %s\n", role)
+						log.Println("Annotating pods for statefulset")
+						for _, pod := range pods.Items {
+							role := RoleReplica
+							if pod.Name == identity {
+								role = RolePrimary
+							}
+							oldRole := pod.Labels["kubedb.com/role"]
+							if _, _, err := core_util.PatchPod(kubeClient, &pod, func(in *core.Pod) *core.Pod {
+								in.Labels["kubedb.com/role"] = role
+								return in
+							}); err != nil {
+								// the failure is only reported, as every member relabels all pods on a leader change, so
+								// another member may still label the pod. Otherwise it is labeled at the next leader change.
+								event(core.EventTypeWarning, EventReasonRelabelFailed,
+									fmt.Sprintf("%s failed to label pod %s as %s. Reason: %v", hostname, pod.Name, role, err))
+							} else if pod.Name == hostname && oldRole != role {
+								// every member relabels all pods, but only reports the role change of its own pod
+								event(core.EventTypeNormal, EventReasonRoleChanged, fmt.Sprintf("%s changed role from %q to %q", pod.Name, oldRole, role))
+							}
 
-					if runningFirstTime {
-						runningFirstTime = false
-						go runWrapperUntilExit(role)
-					}
+						}
+
+						role := RoleReplica
+						if identity == hostname {
+							role = RolePrimary
+						}
+
+						log.Printf("This pod is now a %s\n", role)
+
+						// A new primary starts postgres once it has fenced the previous one
+						if role == RoleReplica {
+							sup.Start(RoleReplica)
+						}
+					},
 				},
-			},
-		})
-		log.Println("Leader election died, exiting DB")
-		terminateDB()
+			})
+			log.Println("Leader election ended, campaigning again")
+		}
 	}()
 
 	doneChan := make(chan os.Signal, 1)
 	signal.Notify(doneChan, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
 	recvSig := <-doneChan
 	log.Printf("Received signal: %s, exiting\n", recvSig)
-	terminateDB()
+	sup.Stop(recvSig)
+}
+
+// electionConfig is the configuration of the leader election sidecar, that the operator passes as environment
+// variables of the pod. Durations are in seconds.
+type electionConfig struct {
+	namespace string
+
+	leaseDuration int
+	renewDeadline int
+	retryPeriod   int
+	lockType      string
+
+	maxLag                      int64
+	fencingTimeout              int
+	healthCheckPeriod           int
+	healthCheckFailureThreshold int
+
+	synchronous         bool
+	synchronousStandbys int
+	synchronousPolicy   api.SynchronousReplicationPolicy
 }
 
-func loadEnvVariables() (namespace string, leaseDuration, renewDeadline, retryPeriod int) {
+// loadEnvVariables returns the configuration from the environment, with defaults for the variables that are not set
+// or invalid.
+func loadEnvVariables() (cfg electionConfig) {
 	var err error
 
-	namespace = os.Getenv("NAMESPACE")
-	if namespace == "" {
-		namespace = "default"
+	cfg.namespace = os.Getenv("NAMESPACE")
+	if cfg.namespace == "" {
+		cfg.namespace = "default"
 	}
 
 	leaseDurationStr := os.Getenv(LeaseDurationEnv)
-	if leaseDuration, err = strconv.Atoi(leaseDurationStr); err != nil || leaseDuration == 0 {
-		leaseDuration = 15
+	if cfg.leaseDuration, err = strconv.Atoi(leaseDurationStr); err != nil || cfg.leaseDuration == 0 {
+		cfg.leaseDuration = 15
 	}
 
 	renewDeadlineStr := os.Getenv(RenewDeadlineEnv)
-	if renewDeadline, err = strconv.Atoi(renewDeadlineStr); err != nil || renewDeadline == 0 {
-		renewDeadline = 10
+	if cfg.renewDeadline, err = strconv.Atoi(renewDeadlineStr); err != nil || cfg.renewDeadline == 0 {
+		cfg.renewDeadline = 10
 	}
 
 	retryPeriodStr := os.Getenv(RetryPeriodEnv)
-	if retryPeriod, err = strconv.Atoi(retryPeriodStr); err != nil || retryPeriod == 0 {
-		retryPeriod = 2
+	if cfg.retryPeriod, err = strconv.Atoi(retryPeriodStr); err != nil || cfg.retryPeriod == 0 {
+		cfg.retryPeriod = 2
+	}
+
+	cfg.lockType = os.Getenv(LockTypeEnv)
+	if cfg.lockType == "" {
+		cfg.lockType = DefaultResourceLock
+	}
+
+	maxLagStr := os.Getenv(MaximumLagBeforeFailoverEnv)
+	// 0 only lets replicas campaign, that have received all WAL of the healthy members
+	if cfg.maxLag, err = strconv.ParseInt(maxLagStr, 10, 64); err != nil || cfg.maxLag < 0 {
+		cfg.maxLag = api.DefaultMaximumLagBeforeFailover
+	}
+
+	fencingTimeoutStr := os.Getenv(FencingTimeoutEnv)
+	if cfg.fencingTimeout, err = strconv.Atoi(fencingTimeoutStr); err != nil || cfg.fencingTimeout <= 0 {
+		cfg.fencingTimeout = api.DefaultFencingTimeoutSeconds
+	}
+
+	healthCheckPeriodStr := os.Getenv(HealthCheckPeriodEnv)
+	if cfg.healthCheckPeriod, err = strconv.Atoi(healthCheckPeriodStr); err != nil || cfg.healthCheckPeriod <= 0 {
+		cfg.healthCheckPeriod = api.DefaultHealthCheckPeriodSeconds
+	}
+
+	healthCheckFailureThresholdStr := os.Getenv(HealthCheckFailureThresholdEnv)
+	if cfg.healthCheckFailureThreshold, err = strconv.Atoi(healthCheckFailureThresholdStr); err != nil || cfg.healthCheckFailureThreshold <= 0 {
+		cfg.healthCheckFailureThreshold = api.DefaultHealthCheckFailureThreshold
+	}
+
+	cfg.synchronous = os.Getenv(StreamingEnv) == strings.ToLower(string(api.SynchronousPostgresStreamingMode))
+
+	synchronousStandbysStr := os.Getenv(SynchronousStandbysEnv)
+	if cfg.synchronousStandbys, err = strconv.Atoi(synchronousStandbysStr); err != nil || cfg.synchronousStandbys <= 0 {
+		cfg.synchronousStandbys = api.DefaultSynchronousStandbys
+	}
+
+	cfg.synchronousPolicy = api.SynchronousReplicationPolicy(os.Getenv(SynchronousReplicationPolicyEnv))
+	if cfg.synchronousPolicy != api.SynchronousReplicationPolicyDegradeToAsync {
+		cfg.synchronousPolicy = api.SynchronousReplicationPolicyStrict
 	}
 
 	return
 }
 
 func setPermission() error {
-	u, err := user.Lookup("postgres")
+	uid, gid, err := postgresUser()
 	if err != nil {
 		return err
 	}
-	uid, err := strconv.Atoi(u.Uid)
+	err = os.Chown("/var/pv", uid, gid)
 	if err != nil {
 		return err
 	}
-	gid, err := strconv.Atoi(u.Gid)
+	return nil
+}
+
+// postgresUser returns the uid and gid of the postgres user, that the wrapper scripts are run as.
+func postgresUser() (int, int, error) {
+	u, err := user.Lookup("postgres")
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
-	err = os.Chown("/var/pv", uid, gid)
+	uid, err := strconv.Atoi(u.Uid)
 	if err != nil {
-		return err
+		return 0, 0, err
+	}
+	gid, err := strconv.Atoi(u.Gid)
+	if err != nil {
+		return 0, 0, err
+	}
+	return uid, gid, nil
+}
+
+// postgresReference returns reference of the Postgres object that owns statefulSet, if any.
+func postgresReference(statefulSet *apps.StatefulSet) *core.ObjectReference {
+	for _, owner := range statefulSet.OwnerReferences {
+		if owner.Kind == api.ResourceKindPostgres {
+			return &core.ObjectReference{
+				APIVersion: owner.APIVersion,
+				Kind:       owner.Kind,
+				Name:       owner.Name,
+				Namespace:  statefulSet.Namespace,
+				UID:        owner.UID,
+			}
+		}
 	}
 	return nil
 }
@@ -0,0 +1,277 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const (
+	// ConfigMapsLeasesResourceLock holds both the ConfigMap and the Lease lock.
+	// It is used while pods of an existing database are moved from ConfigMap to Lease based election.
+	ConfigMapsLeasesResourceLock = "configmapsleases"
+
+	// DefaultResourceLock is used when LockTypeEnv is not set, ie. the pod was created by an older operator.
+	DefaultResourceLock = resourcelock.ConfigMapsResourceLock
+
+	// Annotation of the leader election lock, with the members that have held the leadership and are not confirmed
+	// as stopped yet. Each of them is recorded with the time it was last known to run as primary.
+	AnnotationUnfencedPrimaries = "postgres.kubedb.com/unfenced-primaries"
+)
+
+// newResourceLock returns the leader election lock of the given type for offshoot statefulSet.
+func newResourceLock(lockType, namespace, statefulSetName string, kubeClient kubernetes.Interface, rlc resourcelock.ResourceLockConfig) (resourcelock.Interface, error) {
+	name := GetLeaderLockName(statefulSetName)
+	switch lockType {
+	case resourcelock.ConfigMapsResourceLock, resourcelock.LeasesResourceLock:
+		return resourcelock.New(lockType, namespace, name, kubeClient.CoreV1(), kubeClient.CoordinationV1(), rlc)
+	case ConfigMapsLeasesResourceLock:
+		return &dualLock{
+			primary: &resourcelock.ConfigMapLock{
+				ConfigMapMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				Client:     kubeClient.CoreV1(),
+				LockConfig: rlc,
+			},
+			secondary: &resourcelock.LeaseLock{
+				LeaseMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				Client:     kubeClient.CoordinationV1(),
+				LockConfig: rlc,
+			},
+		}, nil
+	}
+	return nil, fmt.Errorf("invalid leader election lock type %q", lockType)
+}
+
+// dualLock keeps the legacy ConfigMap lock (primary) and the Lease lock (secondary) in sync.
+// Candidates that only know about one of them still see the same leader, so a database can
+// switch lock type through rolling updates without a window where two pods are primary.
+type dualLock struct {
+	primary   resourcelock.Interface
+	secondary resourcelock.Interface
+}
+
+var _ resourcelock.Interface = &dualLock{}
+
+func (dl *dualLock) Get() (*resourcelock.LeaderElectionRecord, error) {
+	primary, err := dl.primary.Get()
+	if err != nil {
+		return nil, err
+	}
+	secondary, err := dl.secondary.Get()
+	if err != nil {
+		// Lease is not created yet, so the lock is held by a pod that only knows about the ConfigMap.
+		if kerr.IsNotFound(err) && primary.HolderIdentity != dl.Identity() {
+			return primary, nil
+		}
+		return nil, err
+	}
+
+	if primary.HolderIdentity != secondary.HolderIdentity {
+		// Report a holder that no candidate can match, along with the latest renewal of the two records.
+		// So, nobody takes over as long as either of the locks is being renewed.
+		record := primary
+		if secondary.RenewTime.After(primary.RenewTime.Time) {
+			record = secondary
+		}
+		record.HolderIdentity = fmt.Sprintf("%s,%s", primary.HolderIdentity, secondary.HolderIdentity)
+		return record, nil
+	}
+	return primary, nil
+}
+
+func (dl *dualLock) Create(ler resourcelock.LeaderElectionRecord) error {
+	if err := dl.primary.Create(ler); err != nil && !kerr.IsAlreadyExists(err) {
+		return err
+	}
+	return dl.secondary.Create(ler)
+}
+
+func (dl *dualLock) Update(ler resourcelock.LeaderElectionRecord) error {
+	if err := dl.primary.Update(ler); err != nil {
+		return err
+	}
+	if _, err := dl.secondary.Get(); kerr.IsNotFound(err) {
+		return dl.secondary.Create(ler)
+	} else if err != nil {
+		return err
+	}
+	return dl.secondary.Update(ler)
+}
+
+func (dl *dualLock) RecordEvent(s string) {
+	dl.primary.RecordEvent(s)
+	dl.secondary.RecordEvent(s)
+}
+
+func (dl *dualLock) Identity() string {
+	return dl.primary.Identity()
+}
+
+func (dl *dualLock) Describe() string {
+	return fmt.Sprintf("%s,%s", dl.primary.Describe(), dl.secondary.Describe())
+}
+
+// unfencedPrimaries records the former primaries as annotation of the objects of the leader election lock, until a
+// leader has fenced them. So, they are not forgotten when a member that acquired the leadership from them fails
+// before fencing them, or acquires it again after a restart.
+type unfencedPrimaries struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+	name       string
+	lockType   string
+}
+
+func newUnfencedPrimaries(lockType, namespace, statefulSetName string, kubeClient kubernetes.Interface) *unfencedPrimaries {
+	return &unfencedPrimaries{
+		kubeClient: kubeClient,
+		namespace:  namespace,
+		name:       GetLeaderLockName(statefulSetName),
+		lockType:   lockType,
+	}
+}
+
+// Get returns the unfenced primaries, with the time they were last known to run as primary.
+func (u *unfencedPrimaries) Get() (map[string]time.Time, error) {
+	members := map[string]time.Time{}
+	err := u.update(func(recorded map[string]time.Time) map[string]time.Time {
+		addUnfenced(members, recorded)
+		return recorded
+	})
+	return members, err
+}
+
+// Add records members as unfenced. A member that is recorded already keeps the later of both times.
+func (u *unfencedPrimaries) Add(members map[string]time.Time) error {
+	return u.update(func(recorded map[string]time.Time) map[string]time.Time {
+		return addUnfenced(recorded, members)
+	})
+}
+
+// Remove forgets the members that have been fenced, unless they have run as primary again since.
+func (u *unfencedPrimaries) Remove(fenced map[string]time.Time) error {
+	return u.update(func(recorded map[string]time.Time) map[string]time.Time {
+		return removeUnfenced(recorded, fenced)
+	})
+}
+
+// update records the result of transform on the unfenced primaries of each object of the lock, if it has changed.
+// Objects that are not created yet are skipped. Concurrent changes of an object fail with a conflict.
+func (u *unfencedPrimaries) update(transform func(map[string]time.Time) map[string]time.Time) error {
+	if u.lockType != resourcelock.LeasesResourceLock {
+		cm, err := u.kubeClient.CoreV1().ConfigMaps(u.namespace).Get(u.name, metav1.GetOptions{})
+		if err == nil {
+			var changed bool
+			if cm.Annotations, changed, err = transformUnfenced(cm.Annotations, transform); err == nil && changed {
+				_, err = u.kubeClient.CoreV1().ConfigMaps(u.namespace).Update(cm)
+			}
+		}
+		if err != nil && !kerr.IsNotFound(err) {
+			return err
+		}
+	}
+	if u.lockType != resourcelock.ConfigMapsResourceLock {
+		lease, err := u.kubeClient.CoordinationV1().Leases(u.namespace).Get(u.name, metav1.GetOptions{})
+		if err == nil {
+			var changed bool
+			if lease.Annotations, changed, err = transformUnfenced(lease.Annotations, transform); err == nil && changed {
+				_, err = u.kubeClient.CoordinationV1().Leases(u.namespace).Update(lease)
+			}
+		}
+		if err != nil && !kerr.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// transformUnfenced returns annotations with the result of transform on the unfenced primaries that they record,
+// and whether it has changed.
+func transformUnfenced(annotations map[string]string, transform func(map[string]time.Time) map[string]time.Time) (map[string]string, bool, error) {
+	cur := annotations[AnnotationUnfencedPrimaries]
+	recorded := map[string]string{}
+	if cur != "" {
+		if err := json.Unmarshal([]byte(cur), &recorded); err != nil {
+			return annotations, false, fmt.Errorf("invalid annotation %s. Reason: %v", AnnotationUnfencedPrimaries, err)
+		}
+	}
+	members := map[string]time.Time{}
+	for member, value := range recorded {
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return annotations, false, fmt.Errorf("invalid annotation %s. Reason: %v", AnnotationUnfencedPrimaries, err)
+		}
+		members[member] = t
+	}
+
+	members = transform(members)
+	value := ""
+	if len(members) > 0 {
+		recorded = map[string]string{}
+		for member, t := range members {
+			recorded[member] = t.UTC().Format(time.RFC3339Nano)
+		}
+		data, err := json.Marshal(recorded)
+		if err != nil {
+			return annotations, false, err
+		}
+		value = string(data)
+	}
+	if value == cur {
+		return annotations, false, nil
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if value == "" {
+		delete(annotations, AnnotationUnfencedPrimaries)
+	} else {
+		annotations[AnnotationUnfencedPrimaries] = value
+	}
+	return annotations, true, nil
+}
+
+// addUnfenced adds members to recorded, keeping the later time of a member that is in both. It returns recorded.
+func addUnfenced(recorded, members map[string]time.Time) map[string]time.Time {
+	for member, t := range members {
+		if cur, found := recorded[member]; !found || t.After(cur) {
+			recorded[member] = t
+		}
+	}
+	return recorded
+}
+
+// removeUnfenced removes the fenced members from recorded, unless they are recorded with a later time. It returns recorded.
+func removeUnfenced(recorded, fenced map[string]time.Time) map[string]time.Time {
+	for member, t := range fenced {
+		if cur, found := recorded[member]; found && !cur.After(t) {
+			delete(recorded, member)
+		}
+	}
+	return recorded
+}
@@ -0,0 +1,225 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+func newTestLock(t *testing.T, client kubernetes.Interface, lockType, identity string) resourcelock.Interface {
+	lock, err := newResourceLock(lockType, "default", "foo", client, resourcelock.ResourceLockConfig{Identity: identity})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return lock
+}
+
+func newRecord(identity string, renew time.Time) resourcelock.LeaderElectionRecord {
+	return resourcelock.LeaderElectionRecord{
+		HolderIdentity:       identity,
+		LeaseDurationSeconds: 15,
+		AcquireTime:          metav1.NewTime(renew),
+		RenewTime:            metav1.NewTime(renew),
+	}
+}
+
+func TestDualLock_HeldByConfigMapOnlyCandidate(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	if err := newTestLock(t, client, resourcelock.ConfigMapsResourceLock, "foo-0").Create(newRecord("foo-0", time.Now())); err != nil {
+		t.Fatal(err)
+	}
+
+	ler, err := newTestLock(t, client, ConfigMapsLeasesResourceLock, "foo-1").Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ler.HolderIdentity != "foo-0" {
+		t.Errorf("expected holder foo-0, got %q", ler.HolderIdentity)
+	}
+}
+
+func TestDualLock_UpdateCreatesLease(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	now := time.Now()
+	if err := newTestLock(t, client, resourcelock.ConfigMapsResourceLock, "foo-0").Create(newRecord("foo-0", now)); err != nil {
+		t.Fatal(err)
+	}
+
+	lock := newTestLock(t, client, ConfigMapsLeasesResourceLock, "foo-1")
+	if _, err := lock.Get(); err != nil {
+		t.Fatal(err)
+	}
+	if err := lock.Update(newRecord("foo-1", now)); err != nil {
+		t.Fatal(err)
+	}
+
+	ler, err := newTestLock(t, client, resourcelock.LeasesResourceLock, "foo-2").Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ler.HolderIdentity != "foo-1" {
+		t.Errorf("expected lease holder foo-1, got %q", ler.HolderIdentity)
+	}
+}
+
+func TestDualLock_DisagreeingHolders(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	now := time.Now()
+	if err := newTestLock(t, client, resourcelock.ConfigMapsResourceLock, "foo-0").Create(newRecord("foo-0", now)); err != nil {
+		t.Fatal(err)
+	}
+	if err := newTestLock(t, client, resourcelock.LeasesResourceLock, "foo-1").Create(newRecord("foo-1", now.Add(time.Second))); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, identity := range []string{"foo-0", "foo-1", "foo-2"} {
+		ler, err := newTestLock(t, client, ConfigMapsLeasesResourceLock, identity).Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ler.HolderIdentity == identity {
+			t.Errorf("%s must not be considered as leader while the locks disagree", identity)
+		}
+		if !ler.RenewTime.After(now) {
+			t.Errorf("expected renew time of the lease, got %v", ler.RenewTime)
+		}
+	}
+}
+
+func TestUnfencedPrimaries(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	now := time.Now()
+	u := newUnfencedPrimaries(ConfigMapsLeasesResourceLock, "default", "foo", client)
+	if err := u.Add(map[string]time.Time{"foo-1": now}); err != nil {
+		t.Errorf("expected nothing to be recorded before the lock is created, got %v", err)
+	}
+	if err := newTestLock(t, client, ConfigMapsLeasesResourceLock, "foo-0").Create(newRecord("foo-0", now)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := u.Add(map[string]time.Time{"foo-1": now, "foo-2": now}); err != nil {
+		t.Fatal(err)
+	}
+	if err := u.Add(map[string]time.Time{"foo-1": now.Add(-time.Minute)}); err != nil {
+		t.Fatal(err)
+	}
+	for _, lockType := range []string{resourcelock.ConfigMapsResourceLock, resourcelock.LeasesResourceLock} {
+		members, err := newUnfencedPrimaries(lockType, "default", "foo", client).Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(members) != 2 || !members["foo-1"].Equal(now) || !members["foo-2"].Equal(now) {
+			t.Errorf("expected foo-1 and foo-2 to be recorded by the %s lock with the later time, got %v", lockType, members)
+		}
+	}
+
+	// a member that has run as primary again since it was fenced stays unfenced
+	if err := u.Remove(map[string]time.Time{"foo-1": now.Add(-time.Minute), "foo-2": now}); err != nil {
+		t.Fatal(err)
+	}
+	members, err := u.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, found := members["foo-1"]; len(members) != 1 || !found {
+		t.Errorf("expected foo-1 to stay unfenced, got %v", members)
+	}
+	if err := u.Remove(members); err != nil {
+		t.Fatal(err)
+	}
+	cm, err := client.CoreV1().ConfigMaps("default").Get("foo-leader-lock", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, found := cm.Annotations[AnnotationUnfencedPrimaries]; found {
+		t.Errorf("expected annotation %s to be removed, got %v", AnnotationUnfencedPrimaries, cm.Annotations)
+	}
+}
+
+func TestCandidacyLock_RecordUnfenced(t *testing.T) {
+	now := time.Now()
+	// a record held by this pod is recorded as well
+	for _, identity := range []string{"foo-0", "foo-1"} {
+		client := fake.NewSimpleClientset()
+		if err := newTestLock(t, client, resourcelock.ConfigMapsResourceLock, "foo-1").Create(newRecord("foo-1", now)); err != nil {
+			t.Fatal(err)
+		}
+		l := &candidacyLock{
+			Interface: newTestLock(t, client, resourcelock.ConfigMapsResourceLock, identity),
+			unfenced:  newUnfencedPrimaries(resourcelock.ConfigMapsResourceLock, "default", "foo", client),
+		}
+		if _, err := l.Get(); err != nil {
+			t.Fatal(err)
+		}
+		if err := l.recordUnfenced(); err != nil {
+			t.Fatal(err)
+		}
+		if err := l.Interface.Update(newRecord(identity, now)); err != nil {
+			t.Fatal(err)
+		}
+		members, err := l.unfenced.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, found := members["foo-1"]; !found {
+			t.Errorf("expected foo-1 to be recorded as unfenced after %s acquired the lock, got %v", identity, members)
+		}
+	}
+}
+
+func TestCandidacyLock_Release(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	if err := newTestLock(t, client, resourcelock.LeasesResourceLock, "foo-0").Create(newRecord("foo-0", time.Now())); err != nil {
+		t.Fatal(err)
+	}
+	l := &candidacyLock{
+		Interface: newTestLock(t, client, resourcelock.LeasesResourceLock, "foo-0"),
+		unfenced:  newUnfencedPrimaries(resourcelock.LeasesResourceLock, "default", "foo", client),
+	}
+	if err := l.HandOver("foo-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	ler, err := l.Interface.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ler.HolderIdentity != "" {
+		t.Errorf("expected the holder of the lease to be cleared, got %q", ler.HolderIdentity)
+	}
+	members, err := l.unfenced.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, found := members["foo-0"]; !found {
+		t.Errorf("expected foo-0 to be recorded as unfenced, got %v", members)
+	}
+	if err := l.Update(newRecord("foo-0", time.Now())); err == nil {
+		t.Error("expected the lease not to be acquired again after it was released")
+	}
+}
+
+func TestNewResourceLock_InvalidType(t *testing.T) {
+	if _, err := newResourceLock("endpoints-and-more", "default", "foo", fake.NewSimpleClientset(), resourcelock.ResourceLockConfig{}); err == nil {
+		t.Error("expected error for invalid lock type")
+	}
+}
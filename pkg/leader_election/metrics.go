@@ -0,0 +1,111 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const (
+	MetricsPath = "/metrics"
+
+	metricsNamespace = "kubedb"
+	metricsSubsystem = "postgres_leader_election"
+)
+
+var (
+	// leadershipTransitions counts how often this pod acquired or lost the leadership
+	leadershipTransitions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "transitions_total",
+		Help:      "Number of times this pod acquired or lost the leadership.",
+	}, []string{"transition"})
+	// failovers counts how often this pod took over from a primary that did not hand over the leadership
+	failovers = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "failovers_total",
+		Help:      "Number of failovers started by this pod.",
+	})
+	// leaderChanges counts the leaders observed by this pod
+	leaderChanges = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "leader_changes_total",
+		Help:      "Number of leader changes observed by this pod.",
+	})
+	// leaderlessSeconds accumulates the time during which this pod observed no valid leader
+	leaderlessSeconds = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "leaderless_seconds_total",
+		Help:      "Total time in seconds during which this pod observed no leader holding a valid lease.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(leadershipTransitions, failovers, leaderChanges, leaderlessSeconds)
+}
+
+const (
+	transitionAcquired = "acquired"
+	transitionLost     = "lost"
+)
+
+// leaderlessTracker accumulates the time between observations of the leader election record, during which
+// the lease was not held by any leader.
+type leaderlessTracker struct {
+	mu         sync.Mutex
+	observed   time.Time
+	leaderless bool
+	counter    prometheus.Counter
+}
+
+// Observe records the state of the leader election record ler at now.
+func (t *leaderlessTracker) Observe(ler *resourcelock.LeaderElectionRecord, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.leaderless && !t.observed.IsZero() && now.After(t.observed) {
+		t.counter.Add(now.Sub(t.observed).Seconds())
+	}
+	t.observed = now
+	t.leaderless = ler == nil || ler.HolderIdentity == "" ||
+		ler.RenewTime.Add(time.Duration(ler.LeaseDurationSeconds)*time.Second).Before(now)
+}
+
+// metricsHandler serves the metrics of the default prometheus registry in text format.
+func metricsHandler(w http.ResponseWriter, r *http.Request) {
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", string(expfmt.FmtText))
+	enc := expfmt.NewEncoder(w, expfmt.FmtText)
+	for _, family := range families {
+		if err := enc.Encode(family); err != nil {
+			return
+		}
+	}
+}
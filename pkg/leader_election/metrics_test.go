@@ -0,0 +1,57 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+func TestLeaderlessTracker(t *testing.T) {
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "test_leaderless_seconds_total"})
+	tracker := &leaderlessTracker{counter: counter}
+
+	start := time.Now()
+	held := &resourcelock.LeaderElectionRecord{
+		HolderIdentity:       "foo-0",
+		LeaseDurationSeconds: 15,
+		RenewTime:            metav1.NewTime(start),
+	}
+	tracker.Observe(held, start)
+	// the lease of foo-0 expires, nobody holds it for 10s
+	tracker.Observe(held, start.Add(20*time.Second))
+	tracker.Observe(nil, start.Add(25*time.Second))
+	held = &resourcelock.LeaderElectionRecord{
+		HolderIdentity:       "foo-1",
+		LeaseDurationSeconds: 15,
+		RenewTime:            metav1.NewTime(start.Add(30 * time.Second)),
+	}
+	tracker.Observe(held, start.Add(30*time.Second))
+	tracker.Observe(held, start.Add(40*time.Second))
+
+	var m dto.Metric
+	if err := counter.Write(&m); err != nil {
+		t.Fatal(err)
+	}
+	if got := m.GetCounter().GetValue(); got != 10 {
+		t.Errorf("leaderless seconds = %v, want 10", got)
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	EventReasonConfigurationReloaded = "ConfigurationReloaded"
+
+	// Directory that the parameters of spec.parameters are mounted at, as ParametersFile.
+	// The postgresql.conf of the database image includes the file, if it exists.
+	ParametersDir  = "/etc/postgresql/parameters"
+	ParametersFile = "parameters.conf"
+)
+
+// ParametersConf returns the content of ParametersFile, that sets params.
+func ParametersConf(params map[string]string) string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.WriteString("# generated by the operator from spec.parameters\n")
+	for _, name := range names {
+		value := strings.Replace(params[name], `\`, `\\`, -1)
+		value = strings.Replace(value, `'`, `''`, -1)
+		fmt.Fprintf(&buf, "%s = '%s'\n", name, value)
+	}
+	return buf.String()
+}
+
+// configFile is a configuration file, that the operator changes in place
+type configFile struct {
+	path string
+	// what the file configures, used in the messages about reloads
+	what string
+	// content of the file, that postgres has loaded
+	loaded []byte
+}
+
+func (f *configFile) read() ([]byte, error) {
+	data, err := ioutil.ReadFile(f.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return data, err
+}
+
+// configReloader reloads the configuration of postgres, whenever the operator changes the parameters in
+// ParametersFile or the client authentication rules in HBAFile. Parameters that are applied at server start
+// only, are applied by the operator restarting the members.
+type configReloader struct {
+	db       *sql.DB
+	files    []*configFile
+	identity string
+
+	// Postgres object to record reloads on. nil if unknown.
+	postgres *core.ObjectReference
+	recorder record.EventRecorder
+}
+
+func newConfigReloader(db *sql.DB, identity string, postgres *core.ObjectReference, recorder record.EventRecorder) *configReloader {
+	r := &configReloader{
+		db: db,
+		files: []*configFile{
+			{path: filepath.Join(ParametersDir, ParametersFile), what: "parameters"},
+			{path: filepath.Join(HBADir, HBAFile), what: "client authentication rules"},
+		},
+		identity: identity,
+		postgres: postgres,
+		recorder: recorder,
+	}
+	// postgres is started with the files, that are mounted already
+	for _, f := range r.files {
+		f.loaded, _ = f.read()
+	}
+	return r
+}
+
+// Run checks for changed files on every member, as ConfigMap volumes are updated in place.
+func (r *configReloader) Run(interval time.Duration, stopCh <-chan struct{}) {
+	wait.Until(r.sync, interval, stopCh)
+}
+
+func (r *configReloader) sync() {
+	var changed []string
+	contents := make([][]byte, len(r.files))
+	for i, f := range r.files {
+		data, err := f.read()
+		if err != nil {
+			log.Printf("failed to read %s: %v\n", f.what, err)
+			return
+		}
+		contents[i] = data
+		if !bytes.Equal(data, f.loaded) {
+			changed = append(changed, f.what)
+		}
+	}
+	if len(changed) == 0 {
+		return
+	}
+	// retried at the next interval, while postgres is not running
+	if _, err := r.db.Exec("SELECT pg_reload_conf()"); err != nil {
+		log.Println("failed to reload the configuration:", err)
+		return
+	}
+	for i, f := range r.files {
+		f.loaded = contents[i]
+	}
+
+	msg := fmt.Sprintf("%s reloaded the configuration with the changed %s", r.identity, strings.Join(changed, " and "))
+	log.Println(msg)
+	if r.postgres != nil {
+		r.recorder.Event(r.postgres, core.EventTypeNormal, EventReasonConfigurationReloaded, msg)
+	}
+}
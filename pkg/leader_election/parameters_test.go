@@ -0,0 +1,57 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import "testing"
+
+func TestParametersConf(t *testing.T) {
+	cases := []struct {
+		name   string
+		params map[string]string
+		want   string
+	}{
+		{
+			name:   "empty",
+			params: nil,
+			want:   "# generated by the operator from spec.parameters\n",
+		},
+		{
+			name: "sorted",
+			params: map[string]string{
+				"work_mem":        "4MB",
+				"max_connections": "200",
+			},
+			want: "# generated by the operator from spec.parameters\n" +
+				"max_connections = '200'\n" +
+				"work_mem = '4MB'\n",
+		},
+		{
+			name: "quoted",
+			params: map[string]string{
+				"log_line_prefix": `%m [%p] '\n'`,
+				"search_path":     `"$user", public`,
+			},
+			want: "# generated by the operator from spec.parameters\n" +
+				`log_line_prefix = '%m [%p] ''\\n'''` + "\n" +
+				`search_path = '"$user", public'` + "\n",
+		},
+	}
+	for _, c := range cases {
+		if got := ParametersConf(c.params); got != c.want {
+			t.Errorf("%s: expected\n%s\ngot\n%s", c.name, c.want, got)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"crypto/md5"
+	"database/sql"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	cs "kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1"
+
+	"github.com/lib/pq"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	EventReasonPasswordApplied = "PasswordApplied"
+
+	// Keys of the database secret, that hold the credentials to rotate to, and the credentials rotated from
+	UserNextKey         = "POSTGRES_USER_NEXT"
+	PasswordNextKey     = "POSTGRES_PASSWORD_NEXT"
+	UserPreviousKey     = "POSTGRES_USER_PREVIOUS"
+	PasswordPreviousKey = "POSTGRES_PASSWORD_PREVIOUS"
+
+	// the superuser alternates between the login roles <user> and <user>_alt with every rotation
+	alternateUserSuffix = "_alt"
+)
+
+// passwordRotation sets the credentials of the database superuser, that the operator has stored in the
+// database secret as UserNextKey and PasswordNextKey, while the rotation is Pending. The new password is set
+// on the alternate login role of the superuser, and the current role is valid until the grace period has
+// passed, so that the members and clients keep working until they are restarted with the new credentials.
+// It runs on the primary, the roles are replicated to the replicas. Applied is only recorded, once the new password
+// is committed. If the operator has given up the rotation meanwhile, and recorded it as Failed, the current role
+// is made valid again.
+type passwordRotation struct {
+	kubeClient kubernetes.Interface
+	dbClient   cs.KubedbV1alpha1Interface
+	db         *sql.DB
+	namespace  string
+	identity   string
+
+	// Postgres object that the rotation is requested on. nil if unknown.
+	postgres *core.ObjectReference
+	recorder record.EventRecorder
+}
+
+// Run checks for pending password rotations, while this pod is the primary.
+func (p *passwordRotation) Run(interval time.Duration, stopCh <-chan struct{}) {
+	if p.postgres == nil {
+		return
+	}
+	wait.Until(p.sync, interval, stopCh)
+}
+
+func (p *passwordRotation) sync() {
+	postgres, err := p.dbClient.Postgreses(p.namespace).Get(p.postgres.Name, metav1.GetOptions{})
+	if err != nil {
+		log.Println("failed to get Postgres:", err)
+		return
+	}
+	rotation := postgres.Status.PasswordRotation
+	if rotation == nil || postgres.Spec.DatabaseSecret == nil ||
+		(rotation.Phase != api.PasswordRotationPhasePending && rotation.Phase != api.PasswordRotationPhaseFailed) {
+		return
+	}
+	secret, err := p.kubeClient.CoreV1().Secrets(p.namespace).Get(postgres.Spec.DatabaseSecret.SecretName, metav1.GetOptions{})
+	if err != nil {
+		log.Println("failed to get database secret:", err)
+		return
+	}
+	user := secretUser(secret)
+	if rotation.Phase == api.PasswordRotationPhaseFailed {
+		if err := restoreLogin(p.db, user); err != nil {
+			log.Printf("failed to restore the login of %s after a failed password rotation. Reason: %v\n", user, err)
+		}
+		return
+	}
+	next := string(secret.Data[PasswordNextKey])
+	if next == "" {
+		return
+	}
+	nextUser := string(secret.Data[UserNextKey])
+	if nextUser == "" {
+		nextUser = AlternateUser(user)
+	}
+
+	// the new password may be committed already, if recording it has failed or the sidecar has restarted since
+	expiry, applied, err := passwordApplied(p.db, user, nextUser, next)
+	if err != nil {
+		log.Println("failed to check the new password:", err)
+		return
+	}
+	if !applied {
+		gracePeriod := api.DefaultPasswordGracePeriod
+		if rotation := postgres.Spec.PasswordRotation; rotation != nil && rotation.GracePeriod != nil {
+			gracePeriod = rotation.GracePeriod.Duration
+		}
+		expiry = time.Now().Add(gracePeriod).Truncate(time.Second)
+		if err := applyPassword(p.db, user, nextUser, next, expiry); err != nil {
+			p.update(postgres, api.PasswordRotationPhaseFailed, fmt.Sprintf("failed to set the new password of %s on primary %s. Reason: %v", nextUser, p.identity, err))
+			return
+		}
+	}
+
+	recorded := postgres.DeepCopy()
+	recorded.Status.PasswordRotation.PreviousPasswordExpiry = &metav1.Time{Time: expiry}
+	p.update(recorded, api.PasswordRotationPhaseApplied, fmt.Sprintf("new password is set on %s on primary %s, %s is valid until %v", nextUser, p.identity, user, expiry.UTC().Format(time.RFC3339)))
+}
+
+// update records the progress of the rotation as event and status of the Postgres object. The status is only
+// updated, if it has not changed since postgres was read. It returns false, if the status is not updated.
+func (p *passwordRotation) update(postgres *api.Postgres, phase api.PasswordRotationPhase, msg string) bool {
+	in := postgres.DeepCopy()
+	in.Status.PasswordRotation.Phase = phase
+	in.Status.PasswordRotation.Reason = msg
+	in.Status.PasswordRotation.LastTransitionTime = metav1.Now()
+	if _, err := p.dbClient.Postgreses(p.namespace).UpdateStatus(in); err != nil {
+		log.Println("failed to update password rotation status:", err)
+		return false
+	}
+
+	log.Println(msg)
+	if phase == api.PasswordRotationPhaseFailed {
+		p.recorder.Event(p.postgres, core.EventTypeWarning, EventReasonPasswordApplied, msg)
+	} else {
+		p.recorder.Event(p.postgres, core.EventTypeNormal, EventReasonPasswordApplied, msg)
+	}
+	return true
+}
+
+// applyPassword sets password on the login role nextUser, and makes the password of user invalid after expiry,
+// in a single transaction.
+func applyPassword(db *sql.DB, user, nextUser, password string, expiry time.Time) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := setPassword(tx, nextUser, password); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := expireLogin(tx, user, expiry); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// passwordApplied returns true and the expiry of user, if password is set on the login role nextUser already.
+func passwordApplied(db *sql.DB, user, nextUser, password string) (time.Time, bool, error) {
+	var set bool
+	var expiry float64
+	err := db.QueryRow(`SELECT COALESCE(n.rolpassword, '') = $3, COALESCE(EXTRACT(EPOCH FROM c.rolvaliduntil), 0)
+		FROM pg_authid n, pg_authid c WHERE n.rolname = $1 AND c.rolname = $2`, nextUser, user, MD5Password(nextUser, password)).Scan(&set, &expiry)
+	if err == sql.ErrNoRows {
+		return time.Time{}, false, nil
+	} else if err != nil {
+		return time.Time{}, false, err
+	}
+	// both are set in the same transaction, the expiry of user is finite once the password is set
+	if !set || expiry <= 0 || math.IsInf(expiry, 0) {
+		return time.Time{}, false, nil
+	}
+	return time.Unix(int64(expiry), 0), true, nil
+}
+
+// restoreLogin makes the password of user valid again, if a rotation has set it to expire, that is recorded as Failed.
+func restoreLogin(db *sql.DB, user string) error {
+	var expires bool
+	err := db.QueryRow("SELECT COALESCE(rolvaliduntil < 'infinity', false) FROM pg_authid WHERE rolname = $1", user).Scan(&expires)
+	if err != nil || !expires {
+		return err
+	}
+	if _, err := db.Exec(fmt.Sprintf("ALTER ROLE %s VALID UNTIL 'infinity'", pq.QuoteIdentifier(user))); err != nil {
+		return err
+	}
+	log.Printf("login of %s is valid again, as the password rotation has failed\n", user)
+	return nil
+}
+
+// setPassword sets the password of the superuser login role user, and creates the role if it does not exist.
+// The password is sent hashed, so that it does not show up in the logs of postgres.
+func setPassword(tx *sql.Tx, user, password string) error {
+	var exists bool
+	if err := tx.QueryRow("SELECT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = $1)", user).Scan(&exists); err != nil {
+		return err
+	}
+	op := "ALTER"
+	if !exists {
+		op = "CREATE"
+	}
+	_, err := tx.Exec(fmt.Sprintf("%s ROLE %s WITH SUPERUSER LOGIN PASSWORD '%s' VALID UNTIL 'infinity'",
+		op, pq.QuoteIdentifier(user), MD5Password(user, password)))
+	return err
+}
+
+// expireLogin makes the password of user invalid after expiry. Connections over the local socket are trusted
+// and not affected.
+func expireLogin(tx *sql.Tx, user string, expiry time.Time) error {
+	_, err := tx.Exec(fmt.Sprintf("ALTER ROLE %s VALID UNTIL '%s'", pq.QuoteIdentifier(user), expiry.UTC().Format(time.RFC3339)))
+	return err
+}
+
+// AlternateUser returns the login role of the pair of user, that the superuser is rotated to from user.
+func AlternateUser(user string) string {
+	if strings.HasSuffix(user, alternateUserSuffix) && user != alternateUserSuffix {
+		return strings.TrimSuffix(user, alternateUserSuffix)
+	}
+	return user + alternateUserSuffix
+}
+
+// superusers returns the login roles of the superuser user and of its alternate, in the order of their names.
+func superusers(user string) []string {
+	users := []string{user, AlternateUser(user)}
+	sort.Strings(users)
+	return users
+}
+
+// secretUser returns the database superuser of the database secret.
+func secretUser(secret *core.Secret) string {
+	if user := string(secret.Data["POSTGRES_USER"]); user != "" {
+		return user
+	}
+	return "postgres"
+}
+
+// MD5Password returns password hashed the way postgres stores it for user with md5 authentication.
+func MD5Password(user, password string) string {
+	return fmt.Sprintf("md5%x", md5.Sum([]byte(password+user)))
+}
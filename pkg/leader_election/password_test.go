@@ -0,0 +1,47 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import "testing"
+
+func TestMD5Password(t *testing.T) {
+	// SELECT rolpassword FROM pg_authid WHERE rolname = 'postgres', after ALTER ROLE postgres PASSWORD 'secret'
+	expected := "md553f48b7c4b76a86ce72276c5755f217d"
+	if got := MD5Password("postgres", "secret"); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+	if MD5Password("admin", "secret") == expected {
+		t.Error("expected the hash to be salted with the user name")
+	}
+}
+
+func TestAlternateUser(t *testing.T) {
+	cases := []struct {
+		user, want string
+	}{
+		{"postgres", "postgres_alt"},
+		{"postgres_alt", "postgres"},
+		{"_alt", "_alt_alt"},
+	}
+	for _, c := range cases {
+		if got := AlternateUser(c.user); got != c.want {
+			t.Errorf("AlternateUser(%q) = %q, want %q", c.user, got, c.want)
+		}
+		if got := superusers(c.user); got[0] > got[1] || got[0] != superusers(c.want)[0] {
+			t.Errorf("expected the same ordered pair for %q and %q, got %v", c.user, c.want, got)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	EventReasonRejoinedWithRewind     = "RejoinedWithRewind"
+	EventReasonRejoinedWithBaseBackup = "RejoinedWithBaseBackup"
+	EventReasonRewindFailed           = "RewindFailed"
+
+	// Environment variable that tells the replica script how the data directory has been rejoined
+	RejoinMethodEnv        = "REJOIN_METHOD"
+	RejoinMethodRewind     = "pg_rewind"
+	RejoinMethodBaseBackup = "pg_basebackup"
+
+	// Maximum duration that a former primary waits for the new primary to be promoted, before it leaves
+	// the rejoin to the replica script
+	RejoinPrimaryTimeout = 5 * time.Minute
+)
+
+var errBecameLeader = errors.New("this pod became leader")
+
+// rejoiner prepares the data directory of a former primary to stream from the new primary. The timeline
+// it has diverged on is rewound with pg_rewind. A fresh base backup is taken, only if the rewind fails.
+type rejoiner struct {
+	dataDir     string
+	primaryHost string
+	identity    string
+
+	// Postgres object to record the chosen method on. nil if unknown.
+	postgres *core.ObjectReference
+	recorder record.EventRecorder
+}
+
+// Rejoin returns the method that the data directory has been rejoined with, or "" if it is left to the
+// replica script to take a fresh base backup.
+func (r *rejoiner) Rejoin() string {
+	if !isFormerPrimary(r.dataDir) {
+		return ""
+	}
+	// pg_rewind and the timeline of the new primary need a remote login of the superuser
+	if superuserLocal(superuser()) {
+		log.Println("Leaving the rejoin to the replica script, as logins of the superuser are restricted to the members")
+		return ""
+	}
+	control, err := controlData(r.dataDir)
+	if err != nil {
+		log.Println("failed to read control data of former primary:", err)
+		return ""
+	}
+	localTimeline, err := strconv.Atoi(control["Latest checkpoint's TimeLineID"])
+	if err != nil {
+		log.Println("failed to read timeline of former primary:", err)
+		return ""
+	}
+
+	primaryTimeline, err := r.primaryTimeline()
+	if err != nil {
+		log.Printf("Leaving the rejoin to the replica script, as the timeline of new primary is unknown. Reason: %v\n", err)
+		return ""
+	}
+	if localTimeline >= primaryTimeline {
+		log.Printf("Former primary is on timeline %d, new primary on %d. Nothing to rewind\n", localTimeline, primaryTimeline)
+		return ""
+	}
+	log.Printf("Former primary has diverged on timeline %d, new primary is on timeline %d\n", localTimeline, primaryTimeline)
+
+	start := time.Now()
+	err = r.rewind(control["Database cluster state"])
+	if err == nil {
+		r.event(core.EventTypeNormal, EventReasonRejoinedWithRewind,
+			fmt.Sprintf("%s rejoined new primary with pg_rewind in %v, after diverging on timeline %d", r.identity, since(start), localTimeline))
+		return RejoinMethodRewind
+	}
+	r.event(core.EventTypeWarning, EventReasonRewindFailed,
+		fmt.Sprintf("pg_rewind of %s failed after %v, falling back to base backup. Reason: %v", r.identity, since(start), err))
+
+	start = time.Now()
+	if err := r.baseBackup(); err != nil {
+		log.Printf("Base backup failed after %v, leaving it to the replica script. Reason: %v\n", since(start), err)
+		return ""
+	}
+	r.event(core.EventTypeNormal, EventReasonRejoinedWithBaseBackup,
+		fmt.Sprintf("%s rejoined new primary with base backup in %v", r.identity, since(start)))
+	return RejoinMethodBaseBackup
+}
+
+// primaryTimeline waits until the new primary is promoted, and returns its timeline.
+func (r *rejoiner) primaryTimeline() (int, error) {
+	db, err := openDB(r.primaryHost)
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+
+	var timeline int
+	err = wait.PollImmediate(2*time.Second, RejoinPrimaryTimeout, func() (bool, error) {
+		if _, err := os.Stat(FailoverTriggerFile); err == nil {
+			return false, errBecameLeader
+		}
+		var inRecovery bool
+		if err := db.QueryRow("SELECT pg_is_in_recovery()").Scan(&inRecovery); err != nil || inRecovery {
+			return false, nil
+		}
+		// The control file of the new primary shows the new timeline only after its first checkpoint
+		if _, err := db.Exec("CHECKPOINT"); err != nil {
+			return false, nil
+		}
+		return db.QueryRow("SELECT timeline_id FROM pg_control_checkpoint()").Scan(&timeline) == nil, nil
+	})
+	return timeline, err
+}
+
+// rewind synchronizes the data directory with the new primary. pg_rewind requires a cleanly shut down
+// data directory, so crash recovery is completed first in single-user mode if needed.
+func (r *rejoiner) rewind(state string) error {
+	if state != "shut down" {
+		log.Printf("Former primary was not shut down cleanly (%s), running crash recovery\n", state)
+		// stdin of the single-user backend is empty, so it exits right after recovery
+		if err := r.command("postgres", "--single", "-D", r.dataDir, "-c", "archive_mode=off", "template1").Run(); err != nil {
+			return fmt.Errorf("crash recovery failed. Reason: %v", err)
+		}
+	}
+	source := sslParams(r.primaryHost)
+	source["host"] = r.primaryHost
+	source["user"] = superuser()
+	source["dbname"] = "postgres"
+
+	log.Println("Running pg_rewind against new primary")
+	return r.command("pg_rewind",
+		"--target-pgdata="+r.dataDir,
+		"--source-server="+conninfo(source),
+	).Run()
+}
+
+// baseBackup replaces the data directory with a fresh base backup of the new primary.
+func (r *rejoiner) baseBackup() error {
+	entries, err := ioutil.ReadDir(r.dataDir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(r.dataDir, entry.Name())); err != nil {
+			return err
+		}
+	}
+	log.Println("Taking base backup of new primary")
+	return r.command("pg_basebackup", "-X", "fetch", "--no-password",
+		"--pgdata", r.dataDir, "--username=postgres", "--host", r.primaryHost).Run()
+}
+
+// command returns a command that runs as postgres user, like the wrapper scripts.
+func (r *rejoiner) command(name string, args ...string) *exec.Cmd {
+	cmd := exec.Command("su-exec", append([]string{"postgres", name}, args...)...)
+	cmd.Env = append(os.Environ(), "PGPASSWORD="+os.Getenv("POSTGRES_PASSWORD"))
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd
+}
+
+func (r *rejoiner) event(eventType, reason, msg string) {
+	log.Println(msg)
+	if r.postgres != nil {
+		r.recorder.Event(r.postgres, eventType, reason, msg)
+	}
+}
+
+// isFormerPrimary returns true, if dataDir holds a database cluster that has not been running as standby.
+func isFormerPrimary(dataDir string) bool {
+	if _, err := os.Stat(filepath.Join(dataDir, "PG_VERSION")); err != nil {
+		return false
+	}
+	_, err := os.Stat(filepath.Join(dataDir, "recovery.conf"))
+	return os.IsNotExist(err)
+}
+
+// controlData returns the fields reported by pg_controldata for dataDir.
+func controlData(dataDir string) (map[string]string, error) {
+	cmd := exec.Command("pg_controldata", dataDir)
+	// field names are translated otherwise
+	cmd.Env = append(os.Environ(), "LC_ALL=C")
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	return parseControlData(string(out)), nil
+}
+
+func parseControlData(out string) map[string]string {
+	fields := map[string]string{}
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), ":", 2)
+		if len(parts) == 2 {
+			fields[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+		}
+	}
+	return fields
+}
+
+func since(start time.Time) time.Duration {
+	return time.Since(start).Round(time.Second)
+}
@@ -0,0 +1,63 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseControlData(t *testing.T) {
+	out := `pg_control version number:            1100
+Database cluster state:               shut down
+Latest checkpoint location:           0/3000060
+Latest checkpoint's TimeLineID:       2
+Latest checkpoint's full_page_writes: on
+`
+	fields := parseControlData(out)
+	if got := fields["Database cluster state"]; got != "shut down" {
+		t.Errorf("expected cluster state 'shut down', got %q", got)
+	}
+	if got := fields["Latest checkpoint's TimeLineID"]; got != "2" {
+		t.Errorf("expected timeline 2, got %q", got)
+	}
+}
+
+func TestIsFormerPrimary(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "pgdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dataDir)
+
+	if isFormerPrimary(dataDir) {
+		t.Error("empty data directory must not be considered as former primary")
+	}
+	if err := ioutil.WriteFile(filepath.Join(dataDir, "PG_VERSION"), []byte("11\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if !isFormerPrimary(dataDir) {
+		t.Error("data directory without recovery.conf must be considered as former primary")
+	}
+	if err := ioutil.WriteFile(filepath.Join(dataDir, "recovery.conf"), nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if isFormerPrimary(dataDir) {
+		t.Error("data directory of a standby must not be considered as former primary")
+	}
+}
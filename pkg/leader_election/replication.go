@@ -0,0 +1,384 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// Annotations written by the leader election sidecar on its own pod
+	AnnotationWalLSN                = "postgres.kubedb.com/wal-lsn"
+	AnnotationWalLSNUpdated         = "postgres.kubedb.com/wal-lsn-updated"
+	AnnotationTimeline              = "postgres.kubedb.com/timeline"
+	AnnotationReplayLagSeconds      = "postgres.kubedb.com/replay-lag-seconds"
+	AnnotationLastArchivedTime      = "postgres.kubedb.com/last-archived-time"
+	AnnotationLastFailedArchiveTime = "postgres.kubedb.com/last-failed-archive-time"
+	AnnotationLastArchivedWAL       = "postgres.kubedb.com/last-archived-wal"
+	AnnotationLastFailedWAL         = "postgres.kubedb.com/last-failed-wal"
+	AnnotationArchiveFailedCount    = "postgres.kubedb.com/archive-failed-count"
+	AnnotationPendingWALFiles       = "postgres.kubedb.com/pending-wal-files"
+	AnnotationLastArchiveError      = "postgres.kubedb.com/last-archive-error"
+
+	// ArchiveErrorFile holds the output of the last failed archive_command of the database image, until a WAL
+	// file is archived again
+	ArchiveErrorFile = "/tmp/archive-last-error"
+	// published archive errors are cut to this length, to keep the pod annotations small
+	maxArchiveErrorLength = 1024
+)
+
+// openLocalDB returns a handle to the postgres server running in this pod.
+func openLocalDB() (*sql.DB, error) {
+	return openDB("localhost")
+}
+
+// superuser returns the name of the database superuser, that the sidecar logs in as.
+func superuser() string {
+	if user := os.Getenv("POSTGRES_USER"); user != "" {
+		return user
+	}
+	return "postgres"
+}
+
+// openDB returns a handle to the postgres server running on host.
+func openDB(host string) (*sql.DB, error) {
+	query := url.Values{"connect_timeout": {"5"}}
+	for k, v := range sslParams(host) {
+		query.Set(k, v)
+	}
+	cnnstr := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(superuser(), os.Getenv("POSTGRES_PASSWORD")),
+		Host:     host + ":5432",
+		Path:     "postgres",
+		RawQuery: query.Encode(),
+	}
+	return sql.Open("postgres", cnnstr.String())
+}
+
+// walLSN returns the WAL position of a postgres server. For a primary it is the current write position,
+// for a replica the latest position that has been received or replayed.
+func walLSN(db *sql.DB) (uint64, error) {
+	receive, replay, current, err := walFunctions(db)
+	if err != nil {
+		return 0, err
+	}
+
+	var lsn string
+	query := fmt.Sprintf(`SELECT CASE WHEN pg_is_in_recovery()
+		THEN GREATEST(COALESCE(%s(), '0/0'), COALESCE(%s(), '0/0'))
+		ELSE %s() END`, receive, replay, current)
+	if err := db.QueryRow(query).Scan(&lsn); err != nil {
+		return 0, err
+	}
+	return ParseLSN(lsn)
+}
+
+// walFunctions returns the names of the functions that report the received, replayed and current WAL position.
+func walFunctions(db *sql.DB) (receive, replay, current string, err error) {
+	var version int
+	if err = db.QueryRow("SHOW server_version_num").Scan(&version); err != nil {
+		return
+	}
+
+	// WAL functions were renamed from xlog/location to wal/lsn in postgres 10
+	if version < 100000 {
+		return "pg_last_xlog_receive_location", "pg_last_xlog_replay_location", "pg_current_xlog_location", nil
+	}
+	return "pg_last_wal_receive_lsn", "pg_last_wal_replay_lsn", "pg_current_wal_lsn", nil
+}
+
+// replicationState reads the timeline of a postgres server, how many seconds its replay is behind the primary
+// and when it has last archived or failed to archive a WAL file. The replay lag of a primary is 0.
+func replicationState(db *sql.DB) (timeline int32, replayLag int64, lastArchived, lastFailed pq.NullTime, err error) {
+	receive, replay, _, err := walFunctions(db)
+	if err != nil {
+		return
+	}
+	// A replica that has replayed everything it received is not behind, even if the primary has been idle since
+	query := fmt.Sprintf(`SELECT
+		COALESCE((SELECT received_tli FROM pg_stat_wal_receiver), (SELECT timeline_id FROM pg_control_checkpoint())),
+		CASE WHEN NOT pg_is_in_recovery() OR %s() = %s() THEN 0
+			ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0) END::bigint,
+		last_archived_time, last_failed_time
+		FROM pg_stat_archiver`, receive, replay)
+	err = db.QueryRow(query).Scan(&timeline, &replayLag, &lastArchived, &lastFailed)
+	return
+}
+
+// archiverState reads the last WAL files that a postgres server has archived and failed to archive, how often
+// archiving has failed and how many WAL files are waiting to be archived.
+func archiverState(db *sql.DB) (lastArchivedWAL, lastFailedWAL string, failedCount, pending int64, err error) {
+	var version int
+	if err = db.QueryRow("SHOW server_version_num").Scan(&version); err != nil {
+		return
+	}
+	// the WAL directory was renamed from pg_xlog to pg_wal in postgres 10
+	walDir := "pg_wal"
+	if version < 100000 {
+		walDir = "pg_xlog"
+	}
+
+	query := fmt.Sprintf(`SELECT COALESCE(last_archived_wal, ''), COALESCE(last_failed_wal, ''), failed_count,
+		(SELECT count(*) FROM pg_ls_dir('%s/archive_status') AS f WHERE f LIKE '%%.ready')
+		FROM pg_stat_archiver`, walDir)
+	err = db.QueryRow(query).Scan(&lastArchivedWAL, &lastFailedWAL, &failedCount, &pending)
+	return
+}
+
+// lastArchiveError returns the output of the last failed archive_command, if archiving has not succeeded since.
+func lastArchiveError() string {
+	data, err := ioutil.ReadFile(ArchiveErrorFile)
+	if err != nil {
+		return ""
+	}
+	msg := strings.TrimSpace(string(data))
+	if len(msg) > maxArchiveErrorLength {
+		// the end of the output tells why wal-g failed
+		msg = msg[len(msg)-maxArchiveErrorLength:]
+	}
+	return msg
+}
+
+// replicationPositions returns the WAL positions flushed by the replicas streaming from the primary,
+// keyed by their application_name, which is the pod name of the replica.
+func replicationPositions(db *sql.DB) (map[string]uint64, error) {
+	var version int
+	if err := db.QueryRow("SHOW server_version_num").Scan(&version); err != nil {
+		return nil, err
+	}
+	column := "flush_lsn"
+	if version < 100000 {
+		column = "flush_location"
+	}
+
+	rows, err := db.Query(fmt.Sprintf(`SELECT application_name, COALESCE(%s, '0/0') FROM pg_stat_replication`, column))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	positions := map[string]uint64{}
+	for rows.Next() {
+		var name, lsn string
+		if err := rows.Scan(&name, &lsn); err != nil {
+			return nil, err
+		}
+		if positions[name], err = ParseLSN(lsn); err != nil {
+			return nil, err
+		}
+	}
+	return positions, rows.Err()
+}
+
+// ParseLSN converts the textual representation of a pg_lsn (ie, "16/B374D848") into a byte position.
+func ParseLSN(lsn string) (uint64, error) {
+	parts := strings.Split(lsn, "/")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("invalid WAL position %q", lsn)
+	}
+	hi, err := strconv.ParseUint(parts[0], 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid WAL position %q. Reason: %v", lsn, err)
+	}
+	lo, err := strconv.ParseUint(parts[1], 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid WAL position %q. Reason: %v", lsn, err)
+	}
+	return hi<<32 | lo, nil
+}
+
+func formatLSN(lsn uint64) string {
+	return fmt.Sprintf("%X/%X", lsn>>32, uint32(lsn))
+}
+
+// walTracker periodically reads the WAL position of the local server and publishes it as annotations
+// of this pod. So, other members can compare their own position with it before they campaign for leadership.
+type walTracker struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+	podName    string
+	db         *sql.DB
+	interval   time.Duration
+	staleAfter time.Duration
+
+	mu      sync.RWMutex
+	lsn     uint64
+	updated time.Time
+}
+
+func newWalTracker(kubeClient kubernetes.Interface, db *sql.DB, namespace, podName string, interval, staleAfter time.Duration) *walTracker {
+	return &walTracker{
+		kubeClient: kubeClient,
+		namespace:  namespace,
+		podName:    podName,
+		db:         db,
+		interval:   interval,
+		staleAfter: staleAfter,
+	}
+}
+
+func (t *walTracker) Run(stopCh <-chan struct{}) {
+	wait.Until(t.sync, t.interval, stopCh)
+}
+
+func (t *walTracker) sync() {
+	lsn, err := walLSN(t.db)
+	if err != nil {
+		// postgres is not running (yet), keep the last published position until it becomes stale.
+		return
+	}
+	now := time.Now()
+
+	t.mu.Lock()
+	t.lsn, t.updated = lsn, now
+	t.mu.Unlock()
+
+	annotations := map[string]string{
+		AnnotationWalLSN:        formatLSN(lsn),
+		AnnotationWalLSNUpdated: now.UTC().Format(time.RFC3339),
+	}
+	if timeline, replayLag, lastArchived, lastFailed, err := replicationState(t.db); err == nil {
+		annotations[AnnotationTimeline] = strconv.Itoa(int(timeline))
+		annotations[AnnotationReplayLagSeconds] = strconv.FormatInt(replayLag, 10)
+		if lastArchived.Valid {
+			annotations[AnnotationLastArchivedTime] = lastArchived.Time.UTC().Format(time.RFC3339)
+		}
+		if lastFailed.Valid {
+			annotations[AnnotationLastFailedArchiveTime] = lastFailed.Time.UTC().Format(time.RFC3339)
+		}
+	} else {
+		log.Println("failed to read replication state:", err)
+	}
+	if lastArchivedWAL, lastFailedWAL, failedCount, pending, err := archiverState(t.db); err == nil {
+		annotations[AnnotationLastArchivedWAL] = lastArchivedWAL
+		annotations[AnnotationLastFailedWAL] = lastFailedWAL
+		annotations[AnnotationArchiveFailedCount] = strconv.FormatInt(failedCount, 10)
+		annotations[AnnotationPendingWALFiles] = strconv.FormatInt(pending, 10)
+		annotations[AnnotationLastArchiveError] = lastArchiveError()
+	} else {
+		log.Println("failed to read archiver state:", err)
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": annotations,
+		},
+	})
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if _, err := t.kubeClient.CoreV1().Pods(t.namespace).Patch(t.podName, types.MergePatchType, patch); err != nil {
+		log.Println("failed to publish WAL position:", err)
+	}
+}
+
+// Position returns the WAL position of the local server, if it has been read recently.
+func (t *walTracker) Position() (uint64, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.lsn, !t.updated.IsZero() && time.Since(t.updated) < t.staleAfter
+}
+
+// memberPosition returns the WAL position published by another member, if it is recent.
+func memberPosition(pod *core.Pod, staleAfter time.Duration) (uint64, bool) {
+	updated, err := time.Parse(time.RFC3339, pod.Annotations[AnnotationWalLSNUpdated])
+	if err != nil || time.Since(updated) > staleAfter {
+		return 0, false
+	}
+	lsn, err := ParseLSN(pod.Annotations[AnnotationWalLSN])
+	if err != nil {
+		return 0, false
+	}
+	return lsn, true
+}
+
+// MemberState is the replication state that the leader election sidecar of a member publishes on its pod.
+type MemberState struct {
+	LSN              uint64
+	Timeline         int32
+	ReplayLagSeconds int64
+	// zero, if unknown
+	LastArchivedTime      time.Time
+	LastFailedArchiveTime time.Time
+	LastArchivedWAL       string
+	LastFailedWAL         string
+	ArchiveFailedCount    int64
+	// number of WAL files waiting to be archived
+	PendingWALFiles  int64
+	LastArchiveError string
+}
+
+// ReadMemberState returns the replication state published on pod, if it is recent.
+func ReadMemberState(pod *core.Pod, staleAfter time.Duration) (MemberState, bool) {
+	var state MemberState
+	var ok bool
+	if state.LSN, ok = memberPosition(pod, staleAfter); !ok {
+		return state, false
+	}
+	if timeline, err := strconv.ParseInt(pod.Annotations[AnnotationTimeline], 10, 32); err == nil {
+		state.Timeline = int32(timeline)
+	}
+	if lag, err := strconv.ParseInt(pod.Annotations[AnnotationReplayLagSeconds], 10, 64); err == nil {
+		state.ReplayLagSeconds = lag
+	}
+	if t, err := time.Parse(time.RFC3339, pod.Annotations[AnnotationLastArchivedTime]); err == nil {
+		state.LastArchivedTime = t
+	}
+	if t, err := time.Parse(time.RFC3339, pod.Annotations[AnnotationLastFailedArchiveTime]); err == nil {
+		state.LastFailedArchiveTime = t
+	}
+	state.LastArchivedWAL = pod.Annotations[AnnotationLastArchivedWAL]
+	state.LastFailedWAL = pod.Annotations[AnnotationLastFailedWAL]
+	if count, err := strconv.ParseInt(pod.Annotations[AnnotationArchiveFailedCount], 10, 64); err == nil {
+		state.ArchiveFailedCount = count
+	}
+	if pending, err := strconv.ParseInt(pod.Annotations[AnnotationPendingWALFiles], 10, 64); err == nil {
+		state.PendingWALFiles = pending
+	}
+	state.LastArchiveError = pod.Annotations[AnnotationLastArchiveError]
+	return state, true
+}
+
+// IsPodReady returns true, if pod is running, not being deleted and has passed its readiness probe.
+func IsPodReady(pod *core.Pod) bool {
+	if pod.DeletionTimestamp != nil || pod.Status.Phase != core.PodRunning {
+		return false
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == core.PodReady {
+			return cond.Status == core.ConditionTrue
+		}
+	}
+	return false
+}
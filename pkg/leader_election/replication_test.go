@@ -0,0 +1,89 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"testing"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseLSN(t *testing.T) {
+	cases := []struct {
+		lsn     string
+		want    uint64
+		wantErr bool
+	}{
+		{"0/0", 0, false},
+		{"0/3000060", 0x3000060, false},
+		{"16/B374D848", 0x16B374D848, false},
+		{"16B374D848", 0, true},
+		{"G/0", 0, true},
+		{"", 0, true},
+	}
+	for _, c := range cases {
+		got, err := ParseLSN(c.lsn)
+		if (err != nil) != c.wantErr {
+			t.Errorf("ParseLSN(%q) error = %v, wantErr %v", c.lsn, err, c.wantErr)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("ParseLSN(%q) = %X, want %X", c.lsn, got, c.want)
+		}
+		if !c.wantErr && formatLSN(got) != c.lsn {
+			t.Errorf("formatLSN(%X) = %q, want %q", got, formatLSN(got), c.lsn)
+		}
+	}
+}
+
+func TestReadMemberState(t *testing.T) {
+	now := time.Now().UTC()
+	pod := &core.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				AnnotationWalLSN:                "0/3000060",
+				AnnotationWalLSNUpdated:         now.Format(time.RFC3339),
+				AnnotationTimeline:              "3",
+				AnnotationReplayLagSeconds:      "12",
+				AnnotationLastArchivedTime:      now.Add(-time.Minute).Format(time.RFC3339),
+				AnnotationLastFailedArchiveTime: "",
+				AnnotationLastArchivedWAL:       "000000030000000000000002",
+				AnnotationArchiveFailedCount:    "4",
+				AnnotationPendingWALFiles:       "2",
+			},
+		},
+	}
+	state, ok := ReadMemberState(pod, time.Minute)
+	if !ok {
+		t.Fatal("ReadMemberState() found no state")
+	}
+	if state.LSN != 0x3000060 || state.Timeline != 3 || state.ReplayLagSeconds != 12 {
+		t.Errorf("ReadMemberState() = %+v", state)
+	}
+	if state.LastArchivedTime.IsZero() || !state.LastFailedArchiveTime.IsZero() {
+		t.Errorf("ReadMemberState() archiver times = %v, %v", state.LastArchivedTime, state.LastFailedArchiveTime)
+	}
+	if state.LastArchivedWAL != "000000030000000000000002" || state.ArchiveFailedCount != 4 || state.PendingWALFiles != 2 {
+		t.Errorf("ReadMemberState() archiver state = %+v", state)
+	}
+
+	pod.Annotations[AnnotationWalLSNUpdated] = now.Add(-2 * time.Minute).Format(time.RFC3339)
+	if _, ok := ReadMemberState(pod, time.Minute); ok {
+		t.Error("ReadMemberState() returned stale state")
+	}
+}
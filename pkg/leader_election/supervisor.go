@@ -0,0 +1,315 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"sync"
+	"syscall"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	EventReasonPostgresCrashLoop = "PostgresCrashLoop"
+
+	// Annotation written by the leader election sidecar on its own pod, whenever it starts postgres
+	AnnotationPostgresStarted = "postgres.kubedb.com/started"
+
+	// Trigger file that promotes a replica to primary
+	FailoverTriggerFile = "/tmp/pg-failover-trigger"
+)
+
+var (
+	// Postgres is restarted with exponential backoff while it keeps crashing. The backoff is reset
+	// once postgres has been running for stableAfter.
+	restartBackoffInitial = time.Second
+	restartBackoffMax     = time.Minute
+	stableAfter           = 5 * time.Minute
+	crashLoopThreshold    = 3
+
+	// Duration that postgres is given to shut down while switching role, before it is killed
+	switchRoleGracePeriod = 30 * time.Second
+)
+
+// process is a running instance of the database wrapper script
+type process struct {
+	cmd    *exec.Cmd
+	exited chan struct{}
+}
+
+// supervisor runs the database wrapper script of the current role, and restarts it with backoff
+// whenever it exits, until it is stopped.
+type supervisor struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+	podName    string
+
+	// Postgres object to record crash loops on. nil if unknown.
+	postgres *core.ObjectReference
+	recorder record.EventRecorder
+
+	// prepare is called before postgres is started as role. It returns additional environment
+	// variables for the wrapper script.
+	prepare func(role string) []string
+	// command returns the command that runs postgres as role
+	command func(role string) *exec.Cmd
+
+	mu        sync.Mutex
+	started   bool
+	role      string
+	proc      *process
+	primary   bool
+	stopping  bool
+	switching bool
+	wake      chan struct{}
+	done      chan struct{}
+}
+
+func newSupervisor(kubeClient kubernetes.Interface, namespace, podName string, postgres *core.ObjectReference, recorder record.EventRecorder) *supervisor {
+	return &supervisor{
+		kubeClient: kubeClient,
+		namespace:  namespace,
+		podName:    podName,
+		postgres:   postgres,
+		recorder:   recorder,
+		command:    wrapperCommand,
+		wake:       make(chan struct{}, 1),
+		done:       make(chan struct{}),
+	}
+}
+
+// Start starts supervising postgres as role. If postgres is supervised already as replica and role is primary,
+// role is only used for later restarts, as a replica is promoted in place through the trigger file.
+// A primary is switched to replica through SwitchRole.
+func (s *supervisor) Start(role string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case s.stopping:
+	case !s.started:
+		s.role, s.started = role, true
+		go s.run()
+	case role == RolePrimary:
+		s.role = role
+		// promoted in place through the trigger file
+		s.primary = s.proc != nil
+	}
+}
+
+// RunsPrimary reports whether postgres has been started or promoted as primary, and has not exited since.
+func (s *supervisor) RunsPrimary() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.primary
+}
+
+// SwitchRole restarts postgres as role, unless it is supervised as role already.
+func (s *supervisor) SwitchRole(role string) {
+	s.mu.Lock()
+	if s.stopping || !s.started || s.role == role {
+		s.role = role
+		s.mu.Unlock()
+		return
+	}
+	s.role, s.switching = role, true
+	proc := s.proc
+	s.mu.Unlock()
+
+	log.Printf("Restarting postgres as %s\n", role)
+	if role == RoleReplica {
+		if err := os.Remove(FailoverTriggerFile); err != nil && !os.IsNotExist(err) {
+			log.Println("failed to remove trigger file:", err)
+		}
+	}
+	if proc != nil {
+		// fast shutdown, so that the role is switched without waiting for clients to disconnect
+		terminate(proc, syscall.SIGINT, switchRoleGracePeriod)
+	}
+	s.wakeUp()
+}
+
+// Stop forwards sig to postgres and waits until it exits. Postgres is not restarted afterwards.
+func (s *supervisor) Stop(sig os.Signal) {
+	s.mu.Lock()
+	s.stopping = true
+	started, proc := s.started, s.proc
+	s.mu.Unlock()
+
+	if !started {
+		log.Println("Postgres is not running, nothing to do")
+		return
+	}
+	if proc != nil {
+		log.Printf("Sending %s to postgres, waiting for it to terminate\n", sig)
+		if err := proc.cmd.Process.Signal(sig); err != nil {
+			log.Println(err)
+		}
+	}
+	s.wakeUp()
+	<-s.done
+}
+
+func (s *supervisor) wakeUp() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *supervisor) run() {
+	defer close(s.done)
+
+	backoff, crashes := restartBackoffInitial, 0
+	for {
+		s.mu.Lock()
+		if s.stopping {
+			s.mu.Unlock()
+			return
+		}
+		role := s.role
+		s.switching = false
+		s.mu.Unlock()
+
+		var env []string
+		if s.prepare != nil {
+			env = s.prepare(role)
+		}
+
+		s.mu.Lock()
+		if s.stopping {
+			s.mu.Unlock()
+			return
+		}
+		if s.switching {
+			s.mu.Unlock()
+			continue
+		}
+		log.Printf("Starting database wrapper script as %s\n", role)
+		cmd := s.command(role)
+		cmd.Env = append(os.Environ(), env...)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		// run in its own process group, so that the wrapper script can be killed along with its children
+		cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+		err := cmd.Start()
+		var proc *process
+		if err == nil {
+			proc = &process{cmd: cmd, exited: make(chan struct{})}
+			s.proc, s.primary = proc, role == RolePrimary
+		}
+		s.mu.Unlock()
+
+		startedAt := time.Now()
+		if proc != nil {
+			s.publishStarted(startedAt)
+			err = cmd.Wait()
+			close(proc.exited)
+		}
+
+		s.mu.Lock()
+		s.proc, s.primary = nil, false
+		stopping, switching := s.stopping, s.switching
+		s.mu.Unlock()
+
+		if stopping {
+			log.Println("Postgres terminated:", exitReason(err))
+			return
+		}
+		if switching {
+			backoff, crashes = restartBackoffInitial, 0
+			continue
+		}
+
+		if time.Since(startedAt) > stableAfter {
+			backoff, crashes = restartBackoffInitial, 0
+		}
+		crashes++
+		msg := fmt.Sprintf("Postgres running as %s on %s exited (%s), restarting in %v", role, s.podName, exitReason(err), backoff)
+		log.Println(msg)
+		if crashes >= crashLoopThreshold && s.postgres != nil {
+			s.recorder.Eventf(s.postgres, core.EventTypeWarning, EventReasonPostgresCrashLoop, "%s. It has crashed %d times in a row", msg, crashes)
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-s.wake:
+		}
+		if backoff *= 2; backoff > restartBackoffMax {
+			backoff = restartBackoffMax
+		}
+	}
+}
+
+// wrapperCommand returns the command that runs the database wrapper script of role as the postgres user.
+func wrapperCommand(role string) *exec.Cmd {
+	// su-exec postgres /scripts/primary/run.sh
+	return exec.Command("su-exec", "postgres", fmt.Sprintf("/scripts/%s/run.sh", role))
+}
+
+// publishStarted records the time postgres has been started at as annotation of this pod. So, a new leader
+// can confirm that postgres of this pod is not running as primary anymore.
+func (s *supervisor) publishStarted(startedAt time.Time) {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				AnnotationPostgresStarted: startedAt.UTC().Format(time.RFC3339Nano),
+			},
+		},
+	})
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if _, err := s.kubeClient.CoreV1().Pods(s.namespace).Patch(s.podName, types.MergePatchType, patch); err != nil {
+		log.Println("failed to publish start time of postgres:", err)
+	}
+}
+
+// terminate sends sig to the wrapper script, or postgres once the script has exec'ed it. If it has not
+// exited after grace, its whole process group is killed.
+func terminate(proc *process, sig syscall.Signal, grace time.Duration) {
+	if err := proc.cmd.Process.Signal(sig); err != nil {
+		log.Println(err)
+	}
+	go func() {
+		select {
+		case <-proc.exited:
+		case <-time.After(grace):
+			log.Printf("Postgres did not terminate within %v, killing it\n", grace)
+			if err := syscall.Kill(-proc.cmd.Process.Pid, syscall.SIGKILL); err != nil {
+				log.Println(err)
+			}
+		}
+	}()
+}
+
+func exitReason(err error) string {
+	if err == nil {
+		return "exit status 0"
+	}
+	return err.Error()
+}
@@ -0,0 +1,152 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"os/exec"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+)
+
+type start struct {
+	role string
+	at   time.Time
+}
+
+// newTestSupervisor returns a supervisor that runs script as postgres, and reports every start.
+func newTestSupervisor(script string) (*supervisor, *record.FakeRecorder, chan start) {
+	pod := &core.Pod{ObjectMeta: metav1.ObjectMeta{Name: "foo-0", Namespace: "default"}}
+	recorder := record.NewFakeRecorder(100)
+	s := newSupervisor(fake.NewSimpleClientset(pod), "default", "foo-0", &core.ObjectReference{Name: "foo"}, recorder)
+	starts := make(chan start, 100)
+	s.prepare = func(role string) []string {
+		starts <- start{role: role, at: time.Now()}
+		return nil
+	}
+	s.command = func(role string) *exec.Cmd {
+		return exec.Command("sh", "-c", script)
+	}
+	return s, recorder, starts
+}
+
+func expectStart(t *testing.T, starts chan start, role string) start {
+	select {
+	case st := <-starts:
+		if st.role != role {
+			t.Fatalf("expected postgres to be started as %s, got %s", role, st.role)
+		}
+		return st
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected postgres to be started as %s", role)
+	}
+	return start{}
+}
+
+func expectNoStart(t *testing.T, starts chan start) {
+	select {
+	case st := <-starts:
+		t.Fatalf("expected postgres not to be restarted, got start as %s", st.role)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestSupervisor_RestartBackoff(t *testing.T) {
+	defer func(initial, max, stable time.Duration) {
+		restartBackoffInitial, restartBackoffMax, stableAfter = initial, max, stable
+	}(restartBackoffInitial, restartBackoffMax, stableAfter)
+
+	cases := []struct {
+		name      string
+		stable    time.Duration
+		want      []time.Duration
+		crashLoop bool
+	}{
+		{"doubled up to the maximum", time.Hour, []time.Duration{20, 40, 80, 80, 80}, true},
+		{"reset after running stable", 0, []time.Duration{20, 20, 20, 20, 20}, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			restartBackoffInitial, restartBackoffMax, stableAfter = 20*time.Millisecond, 80*time.Millisecond, c.stable
+
+			s, recorder, starts := newTestSupervisor("exit 1")
+			s.Start(RoleReplica)
+			var times []time.Time
+			for i := 0; i <= len(c.want); i++ {
+				times = append(times, expectStart(t, starts, RoleReplica).at)
+			}
+			s.Stop(syscall.SIGTERM)
+
+			for i, backoff := range c.want {
+				backoff *= time.Millisecond
+				if gap := times[i+1].Sub(times[i]); gap < backoff || gap > backoff+time.Second {
+					t.Errorf("expected restart %d after %v, got %v", i+1, backoff, gap)
+				}
+			}
+
+			crashLoop := false
+			for len(recorder.Events) > 0 {
+				if strings.Contains(<-recorder.Events, EventReasonPostgresCrashLoop) {
+					crashLoop = true
+				}
+			}
+			if crashLoop != c.crashLoop {
+				t.Errorf("expected event %s to be recorded: %v, got %v", EventReasonPostgresCrashLoop, c.crashLoop, crashLoop)
+			}
+		})
+	}
+}
+
+func TestSupervisor_SwitchRole(t *testing.T) {
+	s, _, starts := newTestSupervisor("exec sleep 30")
+	defer s.Stop(syscall.SIGTERM)
+
+	s.Start(RoleReplica)
+	expectStart(t, starts, RoleReplica)
+	if s.RunsPrimary() {
+		t.Error("expected postgres not to run as primary, when it is started as replica")
+	}
+
+	// a replica is promoted in place
+	s.Start(RolePrimary)
+	if !s.RunsPrimary() {
+		t.Error("expected postgres to run as primary, once it is promoted")
+	}
+	s.SwitchRole(RolePrimary)
+	expectNoStart(t, starts)
+
+	s.SwitchRole(RoleReplica)
+	expectStart(t, starts, RoleReplica)
+	if s.RunsPrimary() {
+		t.Error("expected postgres not to run as primary, once it is restarted as replica")
+	}
+
+	s.SwitchRole(RolePrimary)
+	expectStart(t, starts, RolePrimary)
+	deadline := time.Now().Add(5 * time.Second)
+	for !s.RunsPrimary() && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !s.RunsPrimary() {
+		t.Error("expected postgres to run as primary, once it is restarted as primary")
+	}
+}
@@ -0,0 +1,272 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	cs "kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1"
+	"kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1/util"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	core_util "kmodules.xyz/client-go/core/v1"
+)
+
+const (
+	EventReasonSwitchoverStarted   = "SwitchoverStarted"
+	EventReasonSwitchoverSucceeded = "SwitchoverSucceeded"
+	EventReasonSwitchoverFailed    = "SwitchoverFailed"
+
+	// Maximum duration that the primary waits for the target of a switchover to catch up
+	SwitchoverCatchUpTimeout = time.Minute
+)
+
+// switchover carries out a planned switchover requested on the Postgres object. The primary hands the
+// leadership over to the target, and the target completes the switchover once it has been promoted.
+type switchover struct {
+	kubeClient kubernetes.Interface
+	dbClient   cs.KubedbV1alpha1Interface
+	db         *sql.DB
+	namespace  string
+	identity   string
+	lock       *candidacyLock
+	health     *healthChecker
+
+	// Postgres object that the switchover is requested on. nil if unknown.
+	postgres *core.ObjectReference
+	recorder record.EventRecorder
+
+	// onHandOver is called after the leadership has been handed over, to stop postgres of this pod
+	onHandOver func()
+}
+
+// Run checks for switchovers requested from this pod, while it is the primary.
+func (s *switchover) Run(interval time.Duration, stopCh <-chan struct{}) {
+	if s.postgres == nil {
+		return
+	}
+	wait.Until(s.sync, interval, stopCh)
+}
+
+func (s *switchover) sync() {
+	postgres, err := s.dbClient.Postgreses(s.namespace).Get(s.postgres.Name, metav1.GetOptions{})
+	if err != nil {
+		log.Println("failed to get Postgres:", err)
+		return
+	}
+	sw := postgres.Status.Switchover
+	if sw == nil || sw.Phase != api.SwitchoverPhasePending {
+		return
+	}
+	if sw.Primary != s.identity {
+		s.update(postgres, sw.Target, api.SwitchoverPhaseFailed,
+			fmt.Sprintf("%s became primary before %s could hand over the leadership", s.identity, sw.Primary))
+		return
+	}
+
+	target, err := s.handOver(postgres, sw.Target)
+	if err != nil {
+		s.update(postgres, target, api.SwitchoverPhaseFailed, fmt.Sprintf("failed to hand over the leadership to %s. Reason: %v", target, err))
+		return
+	}
+	s.onHandOver()
+}
+
+// handOver stops writes on this primary, waits until target has flushed all of its WAL and then releases
+// the leadership, which is reserved for target. It returns the chosen target.
+func (s *switchover) handOver(postgres *api.Postgres, requested string) (target string, err error) {
+	target = requested
+	positions, err := replicationPositions(s.db)
+	if err != nil {
+		return target, err
+	}
+	if target, err = s.pickTarget(requested, positions); err != nil {
+		return target, err
+	}
+	postgres = s.update(postgres, target, api.SwitchoverPhaseRunning, fmt.Sprintf("%s is handing over the leadership to %s", s.identity, target))
+
+	// Stop writes, and take this pod out of the primary service. The setting is reset in the configuration file
+	// right after it is loaded, so that it does not persist if postgres of this pod is restarted as primary.
+	s.health.SetPrimary(false)
+	defer func() {
+		if err != nil {
+			s.resume()
+		}
+	}()
+	for _, query := range []string{
+		"ALTER SYSTEM SET default_transaction_read_only TO on",
+		"SELECT pg_reload_conf()",
+		"ALTER SYSTEM RESET default_transaction_read_only",
+	} {
+		if _, err = s.db.Exec(query); err != nil {
+			return target, err
+		}
+	}
+	if err = s.setRole(RoleReplica); err != nil {
+		return target, err
+	}
+
+	if _, err = s.db.Exec("CHECKPOINT"); err != nil {
+		return target, err
+	}
+	lsn, err := walLSN(s.db)
+	if err != nil {
+		return target, err
+	}
+	log.Printf("Waiting for %s to catch up with WAL position %s\n", target, formatLSN(lsn))
+	err = wait.PollImmediate(time.Second, SwitchoverCatchUpTimeout, func() (bool, error) {
+		positions, err := replicationPositions(s.db)
+		if err != nil {
+			return false, nil
+		}
+		return positions[target] >= lsn, nil
+	})
+	if err != nil {
+		return target, fmt.Errorf("%s did not catch up with WAL position %s within %v", target, formatLSN(lsn), SwitchoverCatchUpTimeout)
+	}
+
+	// Reserve the leadership for target from now on, and release it
+	s.update(postgres, target, api.SwitchoverPhaseRunning, fmt.Sprintf("%s has caught up, handing over the leadership", target))
+	err = s.lock.HandOver(target)
+	return target, err
+}
+
+// pickTarget returns requested, if it is a ready replica streaming from this primary. For best-replica,
+// it returns the ready replica that has flushed the most WAL.
+func (s *switchover) pickTarget(requested string, positions map[string]uint64) (string, error) {
+	pods, err := s.kubeClient.CoreV1().Pods(s.namespace).List(metav1.ListOptions{
+		LabelSelector: s.lock.selector,
+	})
+	if err != nil {
+		return requested, err
+	}
+
+	target, found := "", false
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Name == s.identity || !IsPodReady(pod) {
+			continue
+		}
+		if requested != api.SwitchoverBestReplica && pod.Name != requested {
+			continue
+		}
+		lsn, streaming := positions[pod.Name]
+		if !streaming {
+			continue
+		}
+		if !found || lsn > positions[target] {
+			target, found = pod.Name, true
+		}
+	}
+	if !found {
+		return requested, fmt.Errorf("no ready replica is streaming from primary %s", s.identity)
+	}
+	return target, nil
+}
+
+// resume allows writes on this primary again, after the hand over has failed.
+func (s *switchover) resume() {
+	if _, err := s.db.Exec("ALTER SYSTEM RESET default_transaction_read_only"); err != nil {
+		log.Println(err)
+	}
+	if _, err := s.db.Exec("SELECT pg_reload_conf()"); err != nil {
+		log.Println(err)
+	}
+	if err := s.setRole(RolePrimary); err != nil {
+		log.Println(err)
+	}
+	s.health.SetPrimary(true)
+}
+
+func (s *switchover) setRole(role string) error {
+	pod, err := s.kubeClient.CoreV1().Pods(s.namespace).Get(s.identity, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	_, _, err = core_util.PatchPod(s.kubeClient, pod, func(in *core.Pod) *core.Pod {
+		in.Labels[api.LabelRole] = role
+		return in
+	})
+	return err
+}
+
+// Complete marks a switchover to this pod as completed, once it has been promoted or failed to.
+func (s *switchover) Complete(promoteErr error) {
+	if s.postgres == nil {
+		return
+	}
+	postgres, err := s.dbClient.Postgreses(s.namespace).Get(s.postgres.Name, metav1.GetOptions{})
+	if err != nil {
+		log.Println("failed to get Postgres:", err)
+		return
+	}
+	sw := postgres.Status.Switchover
+	if sw == nil || sw.Phase != api.SwitchoverPhaseRunning || sw.Target != s.identity {
+		return
+	}
+	if promoteErr != nil {
+		s.update(postgres, s.identity, api.SwitchoverPhaseFailed, fmt.Sprintf("%s failed to become primary. Reason: %v", s.identity, promoteErr))
+		return
+	}
+	s.update(postgres, s.identity, api.SwitchoverPhaseSucceeded, fmt.Sprintf("%s became primary", s.identity))
+}
+
+// update records the progress of the switchover as event and status of the Postgres object.
+// It returns the updated Postgres object.
+func (s *switchover) update(postgres *api.Postgres, target string, phase api.SwitchoverPhase, msg string) *api.Postgres {
+	log.Println(msg)
+	switch phase {
+	case api.SwitchoverPhaseFailed:
+		s.recorder.Event(s.postgres, core.EventTypeWarning, EventReasonSwitchoverFailed, msg)
+	case api.SwitchoverPhaseSucceeded:
+		s.recorder.Event(s.postgres, core.EventTypeNormal, EventReasonSwitchoverSucceeded, msg)
+	default:
+		s.recorder.Event(s.postgres, core.EventTypeNormal, EventReasonSwitchoverStarted, msg)
+	}
+
+	result, err := util.UpdatePostgresStatus(s.dbClient, postgres, func(in *api.PostgresStatus) *api.PostgresStatus {
+		if in.Switchover == nil {
+			in.Switchover = &api.PostgresSwitchoverStatus{}
+		}
+		in.Switchover.Target = target
+		in.Switchover.Phase = phase
+		in.Switchover.Reason = msg
+		in.Switchover.LastTransitionTime = metav1.Now()
+		return in
+	})
+	if err != nil {
+		log.Println("failed to update switchover status:", err)
+		return postgres
+	}
+	return result
+}
+
+// isSwitchoverTarget returns true, if identity is the target of a running switchover of postgres.
+func isSwitchoverTarget(postgres *api.Postgres, identity string) bool {
+	if postgres == nil {
+		return false
+	}
+	sw := postgres.Status.Switchover
+	return sw != nil && sw.Phase == api.SwitchoverPhaseRunning && sw.Target == identity
+}
@@ -0,0 +1,65 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"testing"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPickTarget(t *testing.T) {
+	var pods []*core.Pod
+	for _, name := range []string{"foo-0", "foo-1", "foo-2", "foo-3"} {
+		pod := newMemberPod(name, time.Now())
+		pod.Status.Conditions = []core.PodCondition{{Type: core.PodReady, Status: core.ConditionTrue}}
+		pods = append(pods, pod)
+	}
+	pods[3].Status.Conditions[0].Status = core.ConditionFalse
+	s := &switchover{
+		kubeClient: fake.NewSimpleClientset(pods[0], pods[1], pods[2], pods[3]),
+		namespace:  "default",
+		identity:   "foo-0",
+		lock:       &candidacyLock{},
+	}
+	positions := map[string]uint64{"foo-1": 100, "foo-2": 200, "foo-3": 300}
+
+	cases := []struct {
+		requested string
+		want      string
+		wantErr   bool
+	}{
+		{api.SwitchoverBestReplica, "foo-2", false},
+		{"foo-1", "foo-1", false},
+		{"foo-0", "", true},
+		{"foo-3", "", true},
+		{"foo-4", "", true},
+	}
+	for _, c := range cases {
+		got, err := s.pickTarget(c.requested, positions)
+		if (err != nil) != c.wantErr {
+			t.Errorf("pickTarget(%q) error = %v, wantErr %v", c.requested, err, c.wantErr)
+			continue
+		}
+		if !c.wantErr && got != c.want {
+			t.Errorf("pickTarget(%q) = %q, want %q", c.requested, got, c.want)
+		}
+	}
+}
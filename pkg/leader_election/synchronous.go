@@ -0,0 +1,238 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+	cs "kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1"
+	"kubedb.dev/apimachinery/client/clientset/versioned/typed/kubedb/v1alpha1/util"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	EventReasonSynchronousStandbysChanged     = "SynchronousStandbysChanged"
+	EventReasonSynchronousReplicationDegraded = "SynchronousReplicationDegraded"
+)
+
+// synchronousReplication maintains synchronous_standby_names of the primary from the replicas that are
+// healthy and caught up.
+type synchronousReplication struct {
+	kubeClient kubernetes.Interface
+	dbClient   cs.KubedbV1alpha1Interface
+	db         *sql.DB
+	namespace  string
+	identity   string
+	selector   string
+
+	// enabled is false with asynchronous streaming, then synchronous_standby_names is only reset
+	enabled bool
+	// number of synchronous standbys that have to confirm a commit
+	standbys int
+	policy   api.SynchronousReplicationPolicy
+	// replicas that have flushed WAL further than maxLag bytes behind the primary are not caught up
+	maxLag int64
+
+	// Postgres object to record the synchronous standbys on. nil if unknown.
+	postgres *core.ObjectReference
+	recorder record.EventRecorder
+}
+
+// Run maintains synchronous_standby_names, while this pod is the primary.
+func (r *synchronousReplication) Run(interval time.Duration, stopCh <-chan struct{}) {
+	wait.Until(r.sync, interval, stopCh)
+}
+
+func (r *synchronousReplication) sync() {
+	var names []string
+	var num int
+	if r.enabled {
+		candidates, err := r.candidates()
+		if err != nil {
+			log.Println("failed to find synchronous standby candidates:", err)
+			return
+		}
+		current, err := r.current()
+		if err != nil {
+			log.Println("failed to read synchronous standbys:", err)
+			return
+		}
+		names, num = chooseSynchronousStandbys(current, candidates, r.standbys, r.policy)
+	}
+
+	setting := formatSynchronousStandbyNames(names, num)
+	var existing string
+	if err := r.db.QueryRow("SHOW synchronous_standby_names").Scan(&existing); err != nil {
+		log.Println("failed to read synchronous_standby_names:", err)
+		return
+	}
+	if existing == setting {
+		return
+	}
+
+	query := "ALTER SYSTEM RESET synchronous_standby_names"
+	if setting != "" || r.enabled {
+		query = fmt.Sprintf("ALTER SYSTEM SET synchronous_standby_names TO '%s'", strings.Replace(setting, "'", "''", -1))
+	}
+	for _, q := range []string{query, "SELECT pg_reload_conf()"} {
+		if _, err := r.db.Exec(q); err != nil {
+			log.Println("failed to update synchronous_standby_names:", err)
+			return
+		}
+	}
+	r.report(names, num)
+}
+
+// candidates returns the ready replicas that stream from this primary and are caught up with it,
+// ordered by their flushed WAL position, highest first.
+func (r *synchronousReplication) candidates() ([]string, error) {
+	positions, err := replicationPositions(r.db)
+	if err != nil {
+		return nil, err
+	}
+	lsn, err := walLSN(r.db)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := r.kubeClient.CoreV1().Pods(r.namespace).List(metav1.ListOptions{
+		LabelSelector: r.selector,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []string
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Name == r.identity || !IsPodReady(pod) {
+			continue
+		}
+		flushed, streaming := positions[pod.Name]
+		if !streaming || (lsn > flushed && lsn-flushed > uint64(r.maxLag)) {
+			continue
+		}
+		candidates = append(candidates, pod.Name)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return positions[candidates[i]] > positions[candidates[j]]
+	})
+	return candidates, nil
+}
+
+// current returns the synchronous standbys recorded in the status of the Postgres object.
+func (r *synchronousReplication) current() ([]string, error) {
+	if r.postgres == nil {
+		return nil, nil
+	}
+	postgres, err := r.dbClient.Postgreses(r.namespace).Get(r.postgres.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return postgres.Status.SynchronousStandbys, nil
+}
+
+// report records the new synchronous standbys as event and status of the Postgres object.
+func (r *synchronousReplication) report(names []string, num int) {
+	eventType, reason := core.EventTypeNormal, EventReasonSynchronousStandbysChanged
+	msg := fmt.Sprintf("%s waits for %d of synchronous standbys %s", r.identity, num, strings.Join(names, ","))
+	switch {
+	case !r.enabled:
+		msg = fmt.Sprintf("%s replicates asynchronously", r.identity)
+	case num < r.standbys:
+		eventType, reason = core.EventTypeWarning, EventReasonSynchronousReplicationDegraded
+		msg = fmt.Sprintf("%s degraded to %d of %d synchronous standbys %s, as not enough replicas are healthy and caught up",
+			r.identity, num, r.standbys, strings.Join(names, ","))
+	case len(names) < num:
+		eventType, reason = core.EventTypeWarning, EventReasonSynchronousReplicationDegraded
+		msg = fmt.Sprintf("%s waits for %d synchronous standbys, while only %s are healthy and caught up", r.identity, num, strings.Join(names, ","))
+	}
+	log.Println(msg)
+
+	if r.postgres == nil {
+		return
+	}
+	r.recorder.Event(r.postgres, eventType, reason, msg)
+
+	postgres, err := r.dbClient.Postgreses(r.namespace).Get(r.postgres.Name, metav1.GetOptions{})
+	if err != nil {
+		log.Println("failed to get Postgres:", err)
+		return
+	}
+	if reflect.DeepEqual(postgres.Status.SynchronousStandbys, names) {
+		return
+	}
+	if _, err := util.UpdatePostgresStatus(r.dbClient, postgres, func(in *api.PostgresStatus) *api.PostgresStatus {
+		in.SynchronousStandbys = names
+		return in
+	}); err != nil {
+		log.Println("failed to update synchronous standbys:", err)
+	}
+}
+
+// chooseSynchronousStandbys returns the synchronous standbys and the number of them that have to confirm a commit.
+// Current standbys stay synchronous as long as they are candidates, the rest is filled with the most caught up
+// candidates. With too few candidates, the Strict policy waits for any standby to confirm, while the DegradeToAsync
+// policy waits only for the candidates.
+func chooseSynchronousStandbys(current, candidates []string, standbys int, policy api.SynchronousReplicationPolicy) ([]string, int) {
+	isCandidate := map[string]bool{}
+	for _, name := range candidates {
+		isCandidate[name] = true
+	}
+
+	var names []string
+	chosen := map[string]bool{}
+	for _, name := range append(append([]string{}, current...), candidates...) {
+		if len(names) == standbys {
+			break
+		}
+		if isCandidate[name] && !chosen[name] {
+			names = append(names, name)
+			chosen[name] = true
+		}
+	}
+	if len(names) == standbys || policy == api.SynchronousReplicationPolicyDegradeToAsync {
+		return names, len(names)
+	}
+	return names, standbys
+}
+
+// formatSynchronousStandbyNames returns the value of synchronous_standby_names that waits for num of names.
+// If there are fewer names than num, any standby may confirm in place of the missing ones.
+func formatSynchronousStandbyNames(names []string, num int) string {
+	if num == 0 {
+		return ""
+	}
+	quoted := make([]string, 0, len(names)+1)
+	for _, name := range names {
+		quoted = append(quoted, fmt.Sprintf("%q", name))
+	}
+	if len(names) < num {
+		quoted = append(quoted, "*")
+	}
+	return fmt.Sprintf("%d (%s)", num, strings.Join(quoted, ", "))
+}
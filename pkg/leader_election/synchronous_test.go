@@ -0,0 +1,52 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"reflect"
+	"testing"
+
+	api "kubedb.dev/apimachinery/apis/kubedb/v1alpha1"
+)
+
+func TestChooseSynchronousStandbys(t *testing.T) {
+	cases := []struct {
+		name       string
+		current    []string
+		candidates []string
+		standbys   int
+		policy     api.SynchronousReplicationPolicy
+		wantNames  []string
+		wantNum    int
+		wantValue  string
+	}{
+		{"current standby is kept", []string{"foo-2"}, []string{"foo-1", "foo-2"}, 1, api.SynchronousReplicationPolicyStrict, []string{"foo-2"}, 1, `1 ("foo-2")`},
+		{"failed standby is replaced", []string{"foo-2"}, []string{"foo-1"}, 1, api.SynchronousReplicationPolicyStrict, []string{"foo-1"}, 1, `1 ("foo-1")`},
+		{"strict waits for any standby", []string{"foo-2"}, []string{"foo-1"}, 2, api.SynchronousReplicationPolicyStrict, []string{"foo-1"}, 2, `2 ("foo-1", *)`},
+		{"strict without candidates", nil, nil, 1, api.SynchronousReplicationPolicyStrict, nil, 1, `1 (*)`},
+		{"degrade to fewer standbys", nil, []string{"foo-1"}, 2, api.SynchronousReplicationPolicyDegradeToAsync, []string{"foo-1"}, 1, `1 ("foo-1")`},
+		{"degrade to async", []string{"foo-1"}, nil, 1, api.SynchronousReplicationPolicyDegradeToAsync, nil, 0, ``},
+	}
+	for _, c := range cases {
+		names, num := chooseSynchronousStandbys(c.current, c.candidates, c.standbys, c.policy)
+		if !reflect.DeepEqual(names, c.wantNames) || num != c.wantNum {
+			t.Errorf("%s: expected %d of %v, got %d of %v", c.name, c.wantNum, c.wantNames, num, names)
+		}
+		if value := formatSynchronousStandbyNames(names, num); value != c.wantValue {
+			t.Errorf("%s: expected synchronous_standby_names %q, got %q", c.name, c.wantValue, value)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	// Environment variable that enables TLS, if set to SSLModeOn or SSLModeRequired
+	SSLModeEnv      = "SSL_MODE"
+	SSLModeOn       = "on"
+	SSLModeRequired = "required"
+
+	// Directories that the server certificate and the replication client certificate are mounted at
+	ServerCertDir = "/tls/server"
+	ClientCertDir = "/tls/client"
+
+	// Environment variable with the options that the wrapper scripts start postgres with
+	PostgresOptionsEnv = "POSTGRES_OPTIONS"
+
+	// Directory that the certificates are copied to, as postgres refuses keys that are readable by others
+	tlsDir = "/tmp/tls"
+
+	TLSCert = "tls.crt"
+	TLSKey  = "tls.key"
+	TLSCA   = "ca.crt"
+)
+
+// setupTLS prepares the certificates mounted in this pod for postgres, if TLS is enabled by SSLModeEnv,
+// and exports the environment that postgres and the libpq clients run by the wrapper scripts inherit.
+func setupTLS(uid, gid int) error {
+	mode := os.Getenv(SSLModeEnv)
+	if mode != SSLModeOn && mode != SSLModeRequired {
+		return nil
+	}
+	// the client authentication rules mounted by the operator reject connections without TLS themselves
+	requireSSL := mode == SSLModeRequired && !hbaMounted()
+	env, err := prepareTLS(ServerCertDir, ClientCertDir, tlsDir, requireSSL, uid, gid)
+	if err != nil {
+		return err
+	}
+	for _, e := range env {
+		kv := strings.SplitN(e, "=", 2)
+		if err := os.Setenv(kv[0], kv[1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prepareTLS copies the server and client certificates into dir, owned by uid and gid and readable
+// only by them, and returns the environment that enables TLS. Postgres is started with ssl on, and
+// libpq clients like pg_basebackup, pg_rewind and the wal receiver verify the primary's certificate
+// and present the replication client certificate. If requireSSL is set, postgres uses a pg_hba.conf
+// in dir, that rejects remote connections without TLS.
+func prepareTLS(serverDir, clientDir, dir string, requireSSL bool, uid, gid int) ([]string, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	if err := os.Chown(dir, uid, gid); err != nil {
+		return nil, err
+	}
+	copyFile := func(src, dst string) (string, error) {
+		data, err := ioutil.ReadFile(src)
+		if err != nil {
+			return "", err
+		}
+		dst = filepath.Join(dir, dst)
+		if err := ioutil.WriteFile(dst, data, 0600); err != nil {
+			return "", err
+		}
+		// WriteFile keeps the mode of an existing file
+		if err := os.Chmod(dst, 0600); err != nil {
+			return "", err
+		}
+		return dst, os.Chown(dst, uid, gid)
+	}
+
+	files := map[string]string{}
+	for _, f := range []struct{ src, dst string }{
+		{filepath.Join(serverDir, TLSCert), "server.crt"},
+		{filepath.Join(serverDir, TLSKey), "server.key"},
+		{filepath.Join(serverDir, TLSCA), "ca.crt"},
+		{filepath.Join(clientDir, TLSCert), "client.crt"},
+		{filepath.Join(clientDir, TLSKey), "client.key"},
+	} {
+		path, err := copyFile(f.src, f.dst)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prepare certificate %s. Reason: %v", f.src, err)
+		}
+		files[f.dst] = path
+	}
+
+	options := []string{
+		"-c ssl=on",
+		"-c ssl_cert_file=" + files["server.crt"],
+		"-c ssl_key_file=" + files["server.key"],
+		"-c ssl_ca_file=" + files["ca.crt"],
+	}
+	if requireSSL {
+		hba := filepath.Join(dir, HBAFile)
+		if err := writeHBA(hba, HBAConf(nil, superuser(), true), uid, gid); err != nil {
+			return nil, err
+		}
+		options = append(options, "-c hba_file="+hba)
+	}
+
+	return []string{
+		PostgresOptionsEnv + "=" + strings.Join(options, " "),
+		"PGSSLMODE=verify-full",
+		"PGSSLCERT=" + files["client.crt"],
+		"PGSSLKEY=" + files["client.key"],
+		"PGSSLROOTCERT=" + files["ca.crt"],
+	}, nil
+}
+
+// sslParams returns the libpq parameters for a connection to the postgres server running on host. Connections
+// to other members use the TLS settings that setupTLS has exported, so that they are encrypted and present the
+// replication client certificate, if remote connections require it. Local connections are not encrypted.
+func sslParams(host string) map[string]string {
+	mode := os.Getenv("PGSSLMODE")
+	if host == "localhost" || mode == "" {
+		return map[string]string{"sslmode": "disable"}
+	}
+	params := map[string]string{"sslmode": mode}
+	for param, env := range map[string]string{"sslcert": "PGSSLCERT", "sslkey": "PGSSLKEY", "sslrootcert": "PGSSLROOTCERT"} {
+		if v := os.Getenv(env); v != "" {
+			params[param] = v
+		}
+	}
+	return params
+}
+
+// conninfo returns params as libpq connection string, in the order of their names.
+func conninfo(params map[string]string) string {
+	fields := make([]string, 0, len(params))
+	for k, v := range params {
+		fields = append(fields, fmt.Sprintf("%s='%s'", k, strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(v)))
+	}
+	sort.Strings(fields)
+	return strings.Join(fields, " ")
+}
@@ -0,0 +1,136 @@
+/*
+Copyright The KubeDB Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package leader_election
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPrepareTLS(t *testing.T) {
+	root, err := ioutil.TempDir("", "tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	serverDir, clientDir, dir := filepath.Join(root, "server"), filepath.Join(root, "client"), filepath.Join(root, "tmp")
+	for _, d := range []string{serverDir, clientDir} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+		for _, f := range []string{TLSCert, TLSKey, TLSCA} {
+			if err := ioutil.WriteFile(filepath.Join(d, f), []byte(d+"/"+f), 0444); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	env, err := prepareTLS(serverDir, clientDir, dir, false, os.Getuid(), os.Getgid())
+	if err != nil {
+		t.Fatal(err)
+	}
+	vars := map[string]string{}
+	for _, e := range env {
+		kv := strings.SplitN(e, "=", 2)
+		vars[kv[0]] = kv[1]
+	}
+	if vars["PGSSLMODE"] != "verify-full" {
+		t.Errorf("expected PGSSLMODE verify-full, got %q", vars["PGSSLMODE"])
+	}
+	if strings.Contains(vars[PostgresOptionsEnv], "hba_file") {
+		t.Errorf("expected no hba_file without requireSSL, got %q", vars[PostgresOptionsEnv])
+	}
+	key := filepath.Join(dir, "server.key")
+	if !strings.Contains(vars[PostgresOptionsEnv], "-c ssl_key_file="+key) {
+		t.Errorf("expected ssl_key_file %s, got %q", key, vars[PostgresOptionsEnv])
+	}
+	info, err := os.Stat(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("expected server key mode 0600, got %v", info.Mode().Perm())
+	}
+	if data, _ := ioutil.ReadFile(vars["PGSSLKEY"]); string(data) != clientDir+"/"+TLSKey {
+		t.Errorf("expected PGSSLKEY to be a copy of the client key, got %q", data)
+	}
+
+	env, err = prepareTLS(serverDir, clientDir, dir, true, os.Getuid(), os.Getgid())
+	if err != nil {
+		t.Fatal(err)
+	}
+	hba := filepath.Join(dir, "pg_hba.conf")
+	if !strings.Contains(env[0], "-c hba_file="+hba) {
+		t.Errorf("expected hba_file %s, got %q", hba, env[0])
+	}
+	data, err := ioutil.ReadFile(hba)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		if strings.HasPrefix(line, "host ") && !strings.Contains(line, "127.0.0.1/32") {
+			t.Errorf("expected remote connections to require TLS, got %q", line)
+		}
+	}
+}
+
+func TestSSLParams(t *testing.T) {
+	env := map[string]string{
+		"PGSSLMODE":     "verify-full",
+		"PGSSLCERT":     "/tmp/tls/client.crt",
+		"PGSSLKEY":      "/tmp/tls/client.key",
+		"PGSSLROOTCERT": "/tmp/tls/ca.crt",
+	}
+	cases := []struct {
+		name string
+		host string
+		tls  bool
+		want string
+	}{
+		{"local", "localhost", true, "sslmode='disable'"},
+		{"remote without tls", "foo-0.foo-pods", false, "sslmode='disable'"},
+		{"remote with tls", "foo-0.foo-pods", true,
+			"sslcert='/tmp/tls/client.crt' sslkey='/tmp/tls/client.key' sslmode='verify-full' sslrootcert='/tmp/tls/ca.crt'"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			for k, v := range env {
+				if !c.tls {
+					v = ""
+				}
+				if err := os.Setenv(k, v); err != nil {
+					t.Fatal(err)
+				}
+				defer os.Unsetenv(k)
+			}
+			if got := conninfo(sslParams(c.host)); got != c.want {
+				t.Errorf("expected %q, got %q", c.want, got)
+			}
+		})
+	}
+}
+
+func TestConninfo(t *testing.T) {
+	got := conninfo(map[string]string{"host": "foo-0", "user": "o'neil", "dbname": `a\b`})
+	want := `dbname='a\\b' host='foo-0' user='o\'neil'`
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
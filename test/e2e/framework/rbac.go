@@ -27,6 +27,10 @@ const (
 	statefulsets        = "statefulsets"
 	pods                = "pods"
 	configmaps          = "configmaps"
+	leases              = "leases"
+	events              = "events"
+	postgreses          = "postgreses"
+	postgresesStatus    = "postgreses/status"
 	podsecuritypolicies = "podsecuritypolicies"
 	rbacApiGroup        = "rbac.authorization.k8s.io"
 	GET                 = "get"
@@ -39,6 +43,8 @@ const (
 	leaderLock          = "-leader-lock"
 	APPS                = "apps"
 	POLICY              = "policy"
+	COORDINATION        = "coordination.k8s.io"
+	KUBEDB              = "kubedb.com"
 	Role                = "Role"
 	ServiceAccount      = "ServiceAccount"
 )
@@ -81,8 +87,10 @@ func (i *Invocation) RoleForPostgres(meta metav1.ObjectMeta) *rbac.Role {
 					pods,
 				},
 				Verbs: []string{
+					GET,
 					LIST,
 					PATCH,
+					DELETE,
 				},
 			},
 			{
@@ -111,6 +119,71 @@ func (i *Invocation) RoleForPostgres(meta metav1.ObjectMeta) *rbac.Role {
 					UPDATE,
 				},
 			},
+			{
+				APIGroups: []string{
+					COORDINATION,
+				},
+				Resources: []string{
+					leases,
+				},
+				Verbs: []string{
+					CREATE,
+				},
+			},
+			{
+				APIGroups: []string{
+					COORDINATION,
+				},
+				ResourceNames: []string{
+					meta.Name + leaderLock,
+				},
+				Resources: []string{
+					leases,
+				},
+				Verbs: []string{
+					GET,
+					UPDATE,
+				},
+			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					events,
+				},
+				Verbs: []string{
+					CREATE,
+				},
+			},
+			{
+				APIGroups: []string{
+					KUBEDB,
+				},
+				ResourceNames: []string{
+					meta.Name,
+				},
+				Resources: []string{
+					postgreses,
+				},
+				Verbs: []string{
+					GET,
+				},
+			},
+			{
+				APIGroups: []string{
+					KUBEDB,
+				},
+				ResourceNames: []string{
+					meta.Name,
+				},
+				Resources: []string{
+					postgresesStatus,
+				},
+				Verbs: []string{
+					UPDATE,
+				},
+			},
 			{
 				APIGroups: []string{
 					POLICY,
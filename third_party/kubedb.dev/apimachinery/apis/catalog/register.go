@@ -0,0 +1,4 @@
+package catalog
+
+// GroupName is the group name use in this package
+const GroupName = "catalog.kubedb.com"
@@ -0,0 +1,126 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+const (
+	ResourceCodePostgresVersion     = "pgversion"
+	ResourceKindPostgresVersion     = "PostgresVersion"
+	ResourceSingularPostgresVersion = "postgresversion"
+	ResourcePluralPostgresVersion   = "postgresversions"
+)
+
+// PostgresVersion defines a Postgres database version.
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=updateStatus
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=postgresversions,singular=postgresversion,scope=Cluster,shortName=pgversion,categories={datastore,kubedb,appscode}
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version"
+// +kubebuilder:printcolumn:name="DB_IMAGE",type="string",JSONPath=".spec.db.image"
+// +kubebuilder:printcolumn:name="Deprecated",type="boolean",JSONPath=".spec.deprecated"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type PostgresVersion struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              PostgresVersionSpec `json:"spec,omitempty"`
+}
+
+// PostgresVersionSpec is the spec for postgres version
+type PostgresVersionSpec struct {
+	// Version
+	Version string `json:"version"`
+	// Database Image
+	DB PostgresVersionDatabase `json:"db"`
+	// Exporter Image
+	Exporter PostgresVersionExporter `json:"exporter"`
+	// Tools Image
+	Tools PostgresVersionTools `json:"tools"`
+	// Deprecated versions usable but regarded as obsolete and best avoided, typically due to having been superseded.
+	// +optional
+	Deprecated bool `json:"deprecated,omitempty"`
+	// PSP names
+	PodSecurityPolicies PostgresVersionPodSecurityPolicy `json:"podSecurityPolicies"`
+	// Parameters is the catalog of the postgresql.conf parameters of this version, that can be set
+	// in spec.parameters of a Postgres
+	// +optional
+	Parameters []PostgresVersionParameter `json:"parameters,omitempty"`
+}
+
+// PostgresVersionDatabase is the Postgres Database image
+type PostgresVersionDatabase struct {
+	Image string `json:"image"`
+}
+
+// PostgresVersionExporter is the image for the Postgres exporter
+type PostgresVersionExporter struct {
+	Image string `json:"image"`
+}
+
+// PostgresVersionTools is the image for the postgres tools
+type PostgresVersionTools struct {
+	Image string `json:"image"`
+}
+
+// PostgresVersionParameter describes a postgresql.conf parameter, as reported by pg_settings
+type PostgresVersionParameter struct {
+	// Name of the parameter
+	Name string `json:"name"`
+	// Context decides when a change of the parameter takes effect
+	Context PostgresParameterContext `json:"context"`
+	// Type of the parameter value
+	Type PostgresParameterType `json:"type"`
+	// EnumValues are the allowed values of an enum parameter
+	// +optional
+	EnumValues []string `json:"enumValues,omitempty"`
+}
+
+// PostgresParameterContext is the context of a parameter in pg_settings
+// +kubebuilder:validation:Enum=internal;postmaster;sighup;superuser-backend;backend;superuser;user
+type PostgresParameterContext string
+
+const (
+	// the parameter can not be changed
+	PostgresParameterContextInternal PostgresParameterContext = "internal"
+	// the parameter is applied at server start only
+	PostgresParameterContextPostmaster PostgresParameterContext = "postmaster"
+	// the parameter is applied, when the configuration is reloaded
+	PostgresParameterContextSighup PostgresParameterContext = "sighup"
+	// the parameter is applied to new sessions, when the configuration is reloaded
+	PostgresParameterContextSuperuserBackend PostgresParameterContext = "superuser-backend"
+	PostgresParameterContextBackend          PostgresParameterContext = "backend"
+	// the parameter is applied, when the configuration is reloaded, and can be changed in sessions as well
+	PostgresParameterContextSuperuser PostgresParameterContext = "superuser"
+	PostgresParameterContextUser      PostgresParameterContext = "user"
+)
+
+// PostgresParameterType is the type of a parameter value in pg_settings
+// +kubebuilder:validation:Enum=bool;integer;real;string;enum
+type PostgresParameterType string
+
+const (
+	PostgresParameterTypeBool    PostgresParameterType = "bool"
+	PostgresParameterTypeInteger PostgresParameterType = "integer"
+	PostgresParameterTypeReal    PostgresParameterType = "real"
+	PostgresParameterTypeString  PostgresParameterType = "string"
+	PostgresParameterTypeEnum    PostgresParameterType = "enum"
+)
+
+// PostgresVersionPodSecurityPolicy is the Postgres pod security policies
+type PostgresVersionPodSecurityPolicy struct {
+	DatabasePolicyName    string `json:"databasePolicyName"`
+	SnapshotterPolicyName string `json:"snapshotterPolicyName"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PostgresVersionList is a list of PostgresVersions
+type PostgresVersionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Items is a list of PostgresVersion CRD objects
+	Items []PostgresVersion `json:"items,omitempty"`
+}
@@ -0,0 +1,73 @@
+package v1alpha1
+
+import (
+	"kubedb.dev/apimachinery/apis"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	crdutils "kmodules.xyz/client-go/apiextensions/v1beta1"
+)
+
+var _ apis.ResourceInfo = &ProxySQLVersion{}
+
+func (p ProxySQLVersion) ResourceShortCode() string {
+	return ""
+}
+
+func (p ProxySQLVersion) ResourceKind() string {
+	return ResourceKindProxySQLVersion
+}
+
+func (p ProxySQLVersion) ResourceSingular() string {
+	return ResourceSingularProxySQLVersion
+}
+
+func (p ProxySQLVersion) ResourcePlural() string {
+	return ResourcePluralProxySQLVersion
+}
+
+func (p ProxySQLVersion) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crdutils.NewCustomResourceDefinition(crdutils.Config{
+		Group:         SchemeGroupVersion.Group,
+		Plural:        ResourcePluralProxySQLVersion,
+		Singular:      ResourceSingularProxySQLVersion,
+		Kind:          ResourceKindProxySQLVersion,
+		Categories:    []string{"datastore", "kubedb", "appscode"},
+		ResourceScope: string(apiextensions.ClusterScoped),
+		Versions: []apiextensions.CustomResourceDefinitionVersion{
+			{
+				Name:    SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Labels: crdutils.Labels{
+			LabelsMap: map[string]string{"app": "kubedb"},
+		},
+		SpecDefinitionName:      "kubedb.dev/apimachinery/apis/catalog/v1alpha1.ProxySQLVersion",
+		EnableValidation:        true,
+		GetOpenAPIDefinitions:   GetOpenAPIDefinitions,
+		EnableStatusSubresource: false,
+		AdditionalPrinterColumns: []apiextensions.CustomResourceColumnDefinition{
+			{
+				Name:     "Version",
+				Type:     "string",
+				JSONPath: ".spec.version",
+			},
+			{
+				Name:     "PROXYSQL_IMAGE",
+				Type:     "string",
+				JSONPath: ".spec.proxysql.image",
+			},
+			{
+				Name:     "Deprecated",
+				Type:     "boolean",
+				JSONPath: ".spec.deprecated",
+			},
+			{
+				Name:     "Age",
+				Type:     "date",
+				JSONPath: ".metadata.creationTimestamp",
+			},
+		},
+	})
+}
@@ -0,0 +1,4 @@
+package config
+
+// GroupName is the group name use in this package
+const GroupName = "config.kubedb.com"
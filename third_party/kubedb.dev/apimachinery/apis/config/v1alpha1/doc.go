@@ -0,0 +1,9 @@
+// Package v1alpha1 is the v1alpha1 version of the API.
+
+// +k8s:deepcopy-gen=package,register
+// +k8s:conversion-gen=kubedb.dev/apimachinery/apis/config
+// +k8s:openapi-gen=true
+// +k8s:defaulter-gen=TypeMeta
+
+// +groupName=config.kubedb.com
+package v1alpha1
@@ -0,0 +1,4 @@
+package kubedb
+
+// GroupName is the group name use in this package
+const GroupName = "kubedb.com"
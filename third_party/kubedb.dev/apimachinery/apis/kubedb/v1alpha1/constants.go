@@ -0,0 +1,108 @@
+package v1alpha1
+
+import "time"
+
+const (
+	DatabaseNamePrefix = "kubedb"
+
+	GenericKey = "kubedb.com"
+
+	LabelDatabaseKind = GenericKey + "/kind"
+	LabelDatabaseName = GenericKey + "/name"
+	LabelRole         = GenericKey + "/role"
+
+	ComponentDatabase = "database"
+	RoleStats         = "stats"
+	DefaultStatsPath  = "/metrics"
+
+	PostgresKey         = ResourceSingularPostgres + "." + GenericKey
+	ElasticsearchKey    = ResourceSingularElasticsearch + "." + GenericKey
+	MySQLKey            = ResourceSingularMySQL + "." + GenericKey
+	PerconaXtraDBKey    = ResourceSingularPerconaXtraDB + "." + GenericKey
+	MongoDBKey          = ResourceSingularMongoDB + "." + GenericKey
+	RedisKey            = ResourceSingularRedis + "." + GenericKey
+	MemcachedKey        = ResourceSingularMemcached + "." + GenericKey
+	EtcdKey             = ResourceSingularEtcd + "." + GenericKey
+	ProxySQLKey         = ResourceSingularProxySQL + "." + GenericKey
+	SnapshotKey         = ResourceSingularSnapshot + "." + GenericKey
+	LabelSnapshotStatus = SnapshotKey + "/status"
+
+	AnnotationInitialized = GenericKey + "/initialized"
+	AnnotationJobType     = GenericKey + "/job-type"
+
+	// Requests a planned switchover of the Postgres primary to the named pod, or to the best replica
+	AnnotationSwitchover  = PostgresKey + "/switchover"
+	SwitchoverBestReplica = "best-replica"
+	// Requests a rolling restart of the Postgres members, whenever its value is changed
+	AnnotationRestart = PostgresKey + "/restart"
+	// Requests a rotation of the Postgres superuser password
+	AnnotationRotatePassword = PostgresKey + "/rotate-password"
+
+	PrometheusExporterPortNumber = 56790
+	PrometheusExporterPortName   = "prom-http"
+
+	JobTypeBackup  = "backup"
+	JobTypeRestore = "restore"
+
+	// Maximum lag in bytes of WAL that a Postgres replica may have to become the primary
+	DefaultMaximumLagBeforeFailover = 32 * 1024 * 1024
+	// Duration in second that a new Postgres primary waits for the previous one to be fenced
+	DefaultFencingTimeoutSeconds = 30
+	// Health checks of the local Postgres server done by the leader election sidecar
+	DefaultHealthCheckPeriodSeconds    = 10
+	DefaultHealthCheckFailureThreshold = 3
+	// Number of replicas that have to confirm a commit with synchronous streaming replication
+	DefaultSynchronousStandbys = 1
+	// Duration that the previous Postgres superuser password is kept in the database secret, after it has been rotated
+	DefaultPasswordGracePeriod = time.Hour
+	// Number of WAL files waiting on the Postgres primary to be archived, above which archiving is behind
+	DefaultMaximumPendingWALFiles = 16
+
+	ElasticsearchRestPort     = 9200
+	ElasticsearchRestPortName = "http"
+	ElasticsearchNodePort     = 9300
+	ElasticsearchNodePortName = "transport"
+
+	MongoDBShardPort    = 27017
+	MongoDBConfigdbPort = 27017
+	MongoDBMongosPort   = 27017
+
+	MySQLUserKey         = "username"
+	MySQLPasswordKey     = "password"
+	MySQLNodePort        = 3306
+	MySQLGroupComPort    = 33060
+	MySQLMaxGroupMembers = 9
+	// The recommended MySQL server version for group replication (GR)
+	MySQLGRRecommendedVersion = "5.7.25"
+	MySQLDefaultGroupSize     = 3
+	MySQLDefaultBaseServerID  = uint(1)
+	// The server id for each group member must be unique and in the range [1, 2^32 - 1]
+	// And the maximum group size is 9. So MySQLMaxBaseServerID is the maximum safe value
+	// for BaseServerID calculated as max MySQL server_id value - max Replication Group size.
+	MySQLMaxBaseServerID = uint(4294967295 - 9)
+
+	PerconaXtraDBClusterRecommendedVersion    = "5.7"
+	PerconaXtraDBMaxClusterNameLength         = 32
+	PerconaXtraDBStandaloneReplicas           = 1
+	PerconaXtraDBDefaultClusterSize           = 3
+	PerconaXtraDBDataMountPath                = "/var/lib/mysql"
+	PerconaXtraDBDataLostFoundPath            = PerconaXtraDBDataMountPath + "lost+found"
+	PerconaXtraDBInitDBMountPath              = "/docker-entrypoint-initdb.d"
+	PerconaXtraDBCustomConfigMountPath        = "/etc/percona-server.conf.d/"
+	PerconaXtraDBClusterCustomConfigMountPath = "/etc/mysql/percona-xtradb-cluster.conf.d/"
+
+	LabelProxySQLName        = ProxySQLKey + "/name"
+	LabelProxySQLLoadBalance = ProxySQLKey + "/load-balance"
+
+	ProxySQLUserKey               = "proxysqluser"
+	ProxySQLPasswordKey           = "proxysqlpass"
+	ProxySQLMySQLNodePort         = 6033
+	ProxySQLAdminPort             = 6032
+	ProxySQLAdminPortName         = "proxyadm"
+	ProxySQLDataMountPath         = "/var/lib/proxysql"
+	ProxySQLCustomConfigMountPath = "/etc/custom-proxysql.cnf"
+
+	RedisShardKey   = RedisKey + "/shard"
+	RedisNodePort   = 6379
+	RedisGossipPort = 16379
+)
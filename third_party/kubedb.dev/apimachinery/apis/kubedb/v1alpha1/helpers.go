@@ -0,0 +1,8 @@
+package v1alpha1
+
+func (e *BackupScheduleSpec) SetDefaults() {
+	if e == nil {
+		return
+	}
+	// Add BackupScheduleSpec defaulting here
+}
@@ -0,0 +1,204 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	"kubedb.dev/apimachinery/apis"
+	"kubedb.dev/apimachinery/apis/kubedb"
+
+	"github.com/appscode/go/types"
+	apps "k8s.io/api/apps/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	crdutils "kmodules.xyz/client-go/apiextensions/v1beta1"
+	meta_util "kmodules.xyz/client-go/meta"
+	appcat "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
+	mona "kmodules.xyz/monitoring-agent-api/api/v1"
+)
+
+var _ apis.ResourceInfo = &MariaDB{}
+
+func (m MariaDB) OffshootName() string {
+	return m.Name
+}
+
+func (m MariaDB) OffshootSelectors() map[string]string {
+	return map[string]string{
+		LabelDatabaseName: m.Name,
+		LabelDatabaseKind: ResourceKindMariaDB,
+	}
+}
+
+func (m MariaDB) OffshootLabels() map[string]string {
+	out := m.OffshootSelectors()
+	out[meta_util.NameLabelKey] = ResourceSingularMariaDB
+	out[meta_util.VersionLabelKey] = string(m.Spec.Version)
+	out[meta_util.InstanceLabelKey] = m.Name
+	out[meta_util.ComponentLabelKey] = ComponentDatabase
+	out[meta_util.ManagedByLabelKey] = GenericKey
+	return meta_util.FilterKeys(GenericKey, out, m.Labels)
+}
+
+func (m MariaDB) ResourceShortCode() string {
+	return ResourceCodeMariaDB
+}
+
+func (m MariaDB) ResourceKind() string {
+	return ResourceKindMariaDB
+}
+
+func (m MariaDB) ResourceSingular() string {
+	return ResourceSingularMariaDB
+}
+
+func (m MariaDB) ResourcePlural() string {
+	return ResourcePluralMariaDB
+}
+
+func (m MariaDB) ServiceName() string {
+	return m.OffshootName()
+}
+
+func (m MariaDB) GoverningServiceName() string {
+	return m.OffshootName() + "-gvr"
+}
+
+type mariadbApp struct {
+	*MariaDB
+}
+
+func (m mariadbApp) Name() string {
+	return m.MariaDB.Name
+}
+
+func (m mariadbApp) Type() appcat.AppType {
+	return appcat.AppType(fmt.Sprintf("%s/%s", kubedb.GroupName, ResourceSingularMariaDB))
+}
+
+func (m MariaDB) AppBindingMeta() appcat.AppBindingMeta {
+	return &mariadbApp{&m}
+}
+
+type mariadbStatsService struct {
+	*MariaDB
+}
+
+func (m mariadbStatsService) GetNamespace() string {
+	return m.MariaDB.GetNamespace()
+}
+
+func (m mariadbStatsService) ServiceName() string {
+	return m.OffshootName() + "-stats"
+}
+
+func (m mariadbStatsService) ServiceMonitorName() string {
+	return fmt.Sprintf("kubedb-%s-%s", m.Namespace, m.Name)
+}
+
+func (m mariadbStatsService) Path() string {
+	return DefaultStatsPath
+}
+
+func (m mariadbStatsService) Scheme() string {
+	return ""
+}
+
+func (m MariaDB) StatsService() mona.StatsAccessor {
+	return &mariadbStatsService{&m}
+}
+
+func (m MariaDB) StatsServiceLabels() map[string]string {
+	lbl := meta_util.FilterKeys(GenericKey, m.OffshootSelectors(), m.Labels)
+	lbl[LabelRole] = RoleStats
+	return lbl
+}
+
+func (m *MariaDB) GetMonitoringVendor() string {
+	if m.Spec.Monitor != nil {
+		return m.Spec.Monitor.Agent.Vendor()
+	}
+	return ""
+}
+
+func (m MariaDB) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crdutils.NewCustomResourceDefinition(crdutils.Config{
+		Group:         SchemeGroupVersion.Group,
+		Plural:        ResourcePluralMariaDB,
+		Singular:      ResourceSingularMariaDB,
+		Kind:          ResourceKindMariaDB,
+		ShortNames:    []string{ResourceCodeMariaDB},
+		Categories:    []string{"datastore", "kubedb", "appscode", "all"},
+		ResourceScope: string(apiextensions.NamespaceScoped),
+		Versions: []apiextensions.CustomResourceDefinitionVersion{
+			{
+				Name:    SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Labels: crdutils.Labels{
+			LabelsMap: map[string]string{"app": "kubedb"},
+		},
+		SpecDefinitionName:      "kubedb.dev/apimachinery/apis/kubedb/v1alpha1.MariaDB",
+		EnableValidation:        true,
+		GetOpenAPIDefinitions:   GetOpenAPIDefinitions,
+		EnableStatusSubresource: true,
+		AdditionalPrinterColumns: []apiextensions.CustomResourceColumnDefinition{
+			{
+				Name:     "Version",
+				Type:     "string",
+				JSONPath: ".spec.version",
+			},
+			{
+				Name:     "Status",
+				Type:     "string",
+				JSONPath: ".status.phase",
+			},
+			{
+				Name:     "Age",
+				Type:     "date",
+				JSONPath: ".metadata.creationTimestamp",
+			},
+		},
+	}, apis.SetNameSchema)
+}
+
+func (m *MariaDB) SetDefaults() {
+	if m == nil {
+		return
+	}
+	m.Spec.SetDefaults()
+}
+
+func (m *MariaDBSpec) SetDefaults() {
+	if m == nil {
+		return
+	}
+
+	if m.Replicas == nil {
+		m.Replicas = types.Int32P(1)
+	}
+
+	// perform defaulting
+
+	if m.StorageType == "" {
+		m.StorageType = StorageTypeDurable
+	}
+	if m.UpdateStrategy.Type == "" {
+		m.UpdateStrategy.Type = apps.RollingUpdateStatefulSetStrategyType
+	}
+	if m.TerminationPolicy == "" {
+		m.TerminationPolicy = TerminationPolicyDelete
+	}
+}
+
+func (m *MariaDBSpec) GetSecrets() []string {
+	if m == nil {
+		return nil
+	}
+
+	var secrets []string
+	if m.DatabaseSecret != nil {
+		secrets = append(secrets, m.DatabaseSecret.SecretName)
+	}
+	return secrets
+}
@@ -0,0 +1,96 @@
+package v1alpha1
+
+import (
+	"kubedb.dev/apimachinery/apis"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	crdutils "kmodules.xyz/client-go/apiextensions/v1beta1"
+)
+
+func (p PostgresDatabase) OffshootLabels() map[string]string {
+	return map[string]string{
+		LabelDatabaseName: p.Spec.DatabaseRef.Name,
+		LabelDatabaseKind: ResourceKindPostgres,
+	}
+}
+
+func (p PostgresDatabase) ResourceShortCode() string {
+	return ResourceCodePostgresDatabase
+}
+
+func (p PostgresDatabase) ResourceKind() string {
+	return ResourceKindPostgresDatabase
+}
+
+func (p PostgresDatabase) ResourceSingular() string {
+	return ResourceSingularPostgresDatabase
+}
+
+func (p PostgresDatabase) ResourcePlural() string {
+	return ResourcePluralPostgresDatabase
+}
+
+func (p PostgresDatabase) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crdutils.NewCustomResourceDefinition(crdutils.Config{
+		Group:         SchemeGroupVersion.Group,
+		Plural:        ResourcePluralPostgresDatabase,
+		Singular:      ResourceSingularPostgresDatabase,
+		Kind:          ResourceKindPostgresDatabase,
+		ShortNames:    []string{ResourceCodePostgresDatabase},
+		Categories:    []string{"datastore", "kubedb", "appscode", "all"},
+		ResourceScope: string(apiextensions.NamespaceScoped),
+		Versions: []apiextensions.CustomResourceDefinitionVersion{
+			{
+				Name:    SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Labels: crdutils.Labels{
+			LabelsMap: map[string]string{"app": "kubedb"},
+		},
+		SpecDefinitionName:      "kubedb.dev/apimachinery/apis/kubedb/v1alpha1.PostgresDatabase",
+		EnableValidation:        true,
+		GetOpenAPIDefinitions:   GetOpenAPIDefinitions,
+		EnableStatusSubresource: true,
+		AdditionalPrinterColumns: []apiextensions.CustomResourceColumnDefinition{
+			{
+				Name:     "Postgres",
+				Type:     "string",
+				JSONPath: ".spec.databaseRef.name",
+			},
+			{
+				Name:     "Status",
+				Type:     "string",
+				JSONPath: ".status.phase",
+			},
+			{
+				Name:     "Age",
+				Type:     "date",
+				JSONPath: ".metadata.creationTimestamp",
+			},
+		},
+	}, apis.SetNameSchema)
+}
+
+func (p *PostgresDatabase) SetDefaults() {
+	if p == nil {
+		return
+	}
+	if p.Spec.Name == "" {
+		p.Spec.Name = p.Name
+	}
+	p.Spec.SetDefaults()
+}
+
+func (p *PostgresDatabaseSpec) SetDefaults() {
+	if p == nil {
+		return
+	}
+	if p.Encoding == "" {
+		p.Encoding = "UTF8"
+	}
+	if p.DeletionPolicy == "" {
+		p.DeletionPolicy = PostgresObjectDeletionPolicyRetain
+	}
+}
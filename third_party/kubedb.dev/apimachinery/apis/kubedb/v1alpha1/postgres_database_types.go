@@ -0,0 +1,106 @@
+package v1alpha1
+
+import (
+	"github.com/appscode/go/encoding/json/types"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ResourceCodePostgresDatabase     = "pgdb"
+	ResourceKindPostgresDatabase     = "PostgresDatabase"
+	ResourceSingularPostgresDatabase = "postgresdatabase"
+	ResourcePluralPostgresDatabase   = "postgresdatabases"
+)
+
+// PostgresDatabase declares a database in a Postgres, that is kept in sync with its spec.
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=postgresdatabases,singular=postgresdatabase,shortName=pgdb,categories={datastore,kubedb,appscode,all}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Postgres",type="string",JSONPath=".spec.databaseRef.name"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type PostgresDatabase struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              PostgresDatabaseSpec   `json:"spec,omitempty"`
+	Status            PostgresDatabaseStatus `json:"status,omitempty"`
+}
+
+type PostgresDatabaseSpec struct {
+	// DatabaseRef is the Postgres in the same namespace, that the database is created in
+	DatabaseRef core.LocalObjectReference `json:"databaseRef"`
+
+	// Name of the database. Defaults to the name of the PostgresDatabase.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Owner is the role that owns the database. Defaults to the superuser.
+	// +optional
+	Owner string `json:"owner,omitempty"`
+
+	// Encoding of the database. It can not be changed once the database is created. Defaults to UTF8.
+	// +optional
+	Encoding string `json:"encoding,omitempty"`
+
+	// Extensions to create in the database
+	// +optional
+	Extensions []string `json:"extensions,omitempty"`
+
+	// DeletionPolicy decides whether the database is dropped, when the PostgresDatabase is deleted.
+	// Defaults to Retain.
+	// +optional
+	DeletionPolicy PostgresObjectDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Delete;Retain
+type PostgresObjectDeletionPolicy string
+
+const (
+	// the database object is dropped, when its resource is deleted
+	PostgresObjectDeletionPolicyDelete PostgresObjectDeletionPolicy = "Delete"
+	// the database object is kept, when its resource is deleted
+	PostgresObjectDeletionPolicyRetain PostgresObjectDeletionPolicy = "Retain"
+)
+
+type PostgresObjectPhase string
+
+const (
+	// used while the Postgres is not running yet
+	PostgresObjectPhasePending PostgresObjectPhase = "Pending"
+	// used once the database object is in sync with its spec
+	PostgresObjectPhaseReady PostgresObjectPhase = "Ready"
+	// used when the database object could not be brought in sync with its spec
+	PostgresObjectPhaseFailed PostgresObjectPhase = "Failed"
+)
+
+type PostgresDatabaseStatus struct {
+	// Phase specifies whether the database is in sync with the spec
+	Phase PostgresObjectPhase `json:"phase,omitempty"`
+	// Reason is used to explain phases of interest
+	Reason string `json:"reason,omitempty"`
+	// Drift lists the differences from the spec, that were found at the last sync
+	// +optional
+	Drift []string `json:"drift,omitempty"`
+	// LastSyncTime is the time that the database was last compared with the spec
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+	// observedGeneration is the most recent generation observed for this resource. It corresponds to the
+	// resource's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration *types.IntHash `json:"observedGeneration,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type PostgresDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Items is a list of PostgresDatabase CRD objects
+	Items []PostgresDatabase `json:"items,omitempty"`
+}
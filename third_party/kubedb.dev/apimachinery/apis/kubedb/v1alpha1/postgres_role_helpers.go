@@ -0,0 +1,101 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	"kubedb.dev/apimachinery/apis"
+
+	core "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	crdutils "kmodules.xyz/client-go/apiextensions/v1beta1"
+)
+
+func (p PostgresRole) OffshootLabels() map[string]string {
+	return map[string]string{
+		LabelDatabaseName: p.Spec.DatabaseRef.Name,
+		LabelDatabaseKind: ResourceKindPostgres,
+	}
+}
+
+func (p PostgresRole) ResourceShortCode() string {
+	return ResourceCodePostgresRole
+}
+
+func (p PostgresRole) ResourceKind() string {
+	return ResourceKindPostgresRole
+}
+
+func (p PostgresRole) ResourceSingular() string {
+	return ResourceSingularPostgresRole
+}
+
+func (p PostgresRole) ResourcePlural() string {
+	return ResourcePluralPostgresRole
+}
+
+func (p PostgresRole) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crdutils.NewCustomResourceDefinition(crdutils.Config{
+		Group:         SchemeGroupVersion.Group,
+		Plural:        ResourcePluralPostgresRole,
+		Singular:      ResourceSingularPostgresRole,
+		Kind:          ResourceKindPostgresRole,
+		ShortNames:    []string{ResourceCodePostgresRole},
+		Categories:    []string{"datastore", "kubedb", "appscode", "all"},
+		ResourceScope: string(apiextensions.NamespaceScoped),
+		Versions: []apiextensions.CustomResourceDefinitionVersion{
+			{
+				Name:    SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Labels: crdutils.Labels{
+			LabelsMap: map[string]string{"app": "kubedb"},
+		},
+		SpecDefinitionName:      "kubedb.dev/apimachinery/apis/kubedb/v1alpha1.PostgresRole",
+		EnableValidation:        true,
+		GetOpenAPIDefinitions:   GetOpenAPIDefinitions,
+		EnableStatusSubresource: true,
+		AdditionalPrinterColumns: []apiextensions.CustomResourceColumnDefinition{
+			{
+				Name:     "Postgres",
+				Type:     "string",
+				JSONPath: ".spec.databaseRef.name",
+			},
+			{
+				Name:     "Status",
+				Type:     "string",
+				JSONPath: ".status.phase",
+			},
+			{
+				Name:     "Age",
+				Type:     "date",
+				JSONPath: ".metadata.creationTimestamp",
+			},
+		},
+	}, apis.SetNameSchema)
+}
+
+func (p *PostgresRole) SetDefaults() {
+	if p == nil {
+		return
+	}
+	if p.Spec.Name == "" {
+		p.Spec.Name = p.Name
+	}
+	if p.Spec.PasswordSecret == nil {
+		p.Spec.PasswordSecret = &core.LocalObjectReference{
+			Name: fmt.Sprintf("%v-password", p.Name),
+		}
+	}
+	p.Spec.SetDefaults()
+}
+
+func (p *PostgresRoleSpec) SetDefaults() {
+	if p == nil {
+		return
+	}
+	if p.DeletionPolicy == "" {
+		p.DeletionPolicy = PostgresObjectDeletionPolicyRetain
+	}
+}
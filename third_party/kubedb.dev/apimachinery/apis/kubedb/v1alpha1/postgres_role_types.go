@@ -0,0 +1,121 @@
+package v1alpha1
+
+import (
+	"github.com/appscode/go/encoding/json/types"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ResourceCodePostgresRole     = "pgrole"
+	ResourceKindPostgresRole     = "PostgresRole"
+	ResourceSingularPostgresRole = "postgresrole"
+	ResourcePluralPostgresRole   = "postgresroles"
+)
+
+// PostgresRole declares a role in a Postgres, that is kept in sync with its spec.
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=postgresroles,singular=postgresrole,shortName=pgrole,categories={datastore,kubedb,appscode,all}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Postgres",type="string",JSONPath=".spec.databaseRef.name"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type PostgresRole struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              PostgresRoleSpec   `json:"spec,omitempty"`
+	Status            PostgresRoleStatus `json:"status,omitempty"`
+}
+
+type PostgresRoleSpec struct {
+	// DatabaseRef is the Postgres in the same namespace, that the role is created in
+	DatabaseRef core.LocalObjectReference `json:"databaseRef"`
+
+	// Name of the role. Defaults to the name of the PostgresRole.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Login allows the role to log in with the password from PasswordSecret
+	// +optional
+	Login bool `json:"login,omitempty"`
+
+	// Privileges are the attributes of the role, that allow it to bypass checks or manage the server
+	// +optional
+	Privileges []PostgresRolePrivilege `json:"privileges,omitempty"`
+
+	// ConnectionLimit is the number of concurrent connections the role can make. Defaults to no limit.
+	// +optional
+	ConnectionLimit *int32 `json:"connectionLimit,omitempty"`
+
+	// MemberOf lists the roles, that the role is granted membership in
+	// +optional
+	MemberOf []string `json:"memberOf,omitempty"`
+
+	// Grants of privileges on databases and the tables of their schemas
+	// +optional
+	Grants []PostgresGrant `json:"grants,omitempty"`
+
+	// PasswordSecret holds the password of a login role as "password", and its name as "username".
+	// The secret is generated with a random password, if it does not exist. Defaults to <name>-password.
+	// +optional
+	PasswordSecret *core.LocalObjectReference `json:"passwordSecret,omitempty"`
+
+	// DeletionPolicy decides whether the role is dropped, when the PostgresRole is deleted.
+	// Objects owned by a dropped role are reassigned to the superuser. Defaults to Retain.
+	// +optional
+	DeletionPolicy PostgresObjectDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Superuser;CreateDB;CreateRole;Replication;BypassRLS
+type PostgresRolePrivilege string
+
+const (
+	PostgresRolePrivilegeSuperuser   PostgresRolePrivilege = "Superuser"
+	PostgresRolePrivilegeCreateDB    PostgresRolePrivilege = "CreateDB"
+	PostgresRolePrivilegeCreateRole  PostgresRolePrivilege = "CreateRole"
+	PostgresRolePrivilegeReplication PostgresRolePrivilege = "Replication"
+	PostgresRolePrivilegeBypassRLS   PostgresRolePrivilege = "BypassRLS"
+)
+
+type PostgresGrant struct {
+	// Database that the privileges are granted in
+	Database string `json:"database"`
+	// Schema whose tables the privileges are granted on, including tables created later by the owner of the schema.
+	// The privileges are granted on the database itself, if it is empty.
+	// +optional
+	Schema string `json:"schema,omitempty"`
+	// Privileges to grant, eg. CONNECT, CREATE and TEMPORARY on a database,
+	// or SELECT, INSERT, UPDATE and DELETE on tables
+	Privileges []string `json:"privileges"`
+}
+
+type PostgresRoleStatus struct {
+	// Phase specifies whether the role is in sync with the spec
+	Phase PostgresObjectPhase `json:"phase,omitempty"`
+	// Reason is used to explain phases of interest
+	Reason string `json:"reason,omitempty"`
+	// Drift lists the differences from the spec, that were found at the last sync
+	// +optional
+	Drift []string `json:"drift,omitempty"`
+	// LastSyncTime is the time that the role was last compared with the spec
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+	// observedGeneration is the most recent generation observed for this resource. It corresponds to the
+	// resource's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration *types.IntHash `json:"observedGeneration,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type PostgresRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Items is a list of PostgresRole CRD objects
+	Items []PostgresRole `json:"items,omitempty"`
+}
@@ -0,0 +1,723 @@
+package v1alpha1
+
+import (
+	"github.com/appscode/go/encoding/json/types"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	mona "kmodules.xyz/monitoring-agent-api/api/v1"
+	store "kmodules.xyz/objectstore-api/api/v1"
+	ofst "kmodules.xyz/offshoot-api/api/v1"
+)
+
+const (
+	ResourceCodePostgres     = "pg"
+	ResourceKindPostgres     = "Postgres"
+	ResourceSingularPostgres = "postgres"
+	ResourcePluralPostgres   = "postgreses"
+)
+
+// Postgres defines a Postgres database.
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=postgreses,singular=postgres,shortName=pg,categories={datastore,kubedb,appscode,all}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Primary",type="string",JSONPath=".status.primary"
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.readyMembers"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type Postgres struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              PostgresSpec   `json:"spec,omitempty"`
+	Status            PostgresStatus `json:"status,omitempty"`
+}
+
+type PostgresSpec struct {
+	// Version of Postgres to be deployed.
+	Version types.StrYo `json:"version"`
+
+	// Number of instances to deploy for a Postgres database.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Standby mode
+	StandbyMode *PostgresStandbyMode `json:"standbyMode,omitempty"`
+
+	// Streaming mode
+	StreamingMode *PostgresStreamingMode `json:"streamingMode,omitempty"`
+
+	// SynchronousReplication configures the synchronous standbys of the primary,
+	// if streamingMode is Synchronous
+	// +optional
+	SynchronousReplication *PostgresSynchronousReplication `json:"synchronousReplication,omitempty"`
+
+	// Archive for wal files
+	Archiver *PostgresArchiverSpec `json:"archiver,omitempty"`
+
+	// Leader election configuration
+	// +optional
+	LeaderElection *LeaderElectionConfig `json:"leaderElection,omitempty"`
+
+	// Database authentication secret
+	DatabaseSecret *core.SecretVolumeSource `json:"databaseSecret,omitempty"`
+
+	// TLS encrypts client and replication connections with certificates issued by the operator
+	// +optional
+	TLS *PostgresTLSConfig `json:"tls,omitempty"`
+
+	// PasswordRotation rotates the password of the database superuser on a schedule
+	// +optional
+	PasswordRotation *PostgresPasswordRotationSpec `json:"passwordRotation,omitempty"`
+
+	// ClientAuthentication declares the rules of pg_hba.conf, that decide how clients are authenticated
+	// +optional
+	ClientAuthentication *PostgresClientAuthenticationSpec `json:"clientAuthentication,omitempty"`
+
+	// StorageType can be durable (default) or ephemeral
+	StorageType StorageType `json:"storageType,omitempty"`
+
+	// Storage to specify how storage shall be used.
+	Storage *core.PersistentVolumeClaimSpec `json:"storage,omitempty"`
+
+	// Init is used to initialize database
+	// +optional
+	Init *InitSpec `json:"init,omitempty"`
+
+	// BackupSchedule spec to specify how database backup will be taken
+	// +optional
+	BackupSchedule *BackupScheduleSpec `json:"backupSchedule,omitempty"`
+
+	// Monitor is used monitor database instance
+	// +optional
+	Monitor *mona.AgentSpec `json:"monitor,omitempty"`
+
+	// ConfigSource is an optional field to provide custom configuration file for database (i.e postgresql.conf).
+	// If specified, this file will be used as configuration file otherwise default configuration file will be used.
+	ConfigSource *core.VolumeSource `json:"configSource,omitempty"`
+
+	// Parameters are postgresql.conf parameters, validated against the parameter catalog of the PostgresVersion.
+	// They take precedence over the configuration file of ConfigSource. A change is applied by reloading the
+	// configuration, or by restarting the members, if the parameter can only be set at server start.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// PodTemplate is an optional configuration for pods used to expose database
+	// +optional
+	PodTemplate ofst.PodTemplateSpec `json:"podTemplate,omitempty"`
+
+	// ServiceTemplate is an optional configuration for service used to expose database
+	// +optional
+	ServiceTemplate ofst.ServiceTemplateSpec `json:"serviceTemplate,omitempty"`
+
+	// ReplicaServiceTemplate is an optional configuration for service used to expose postgres replicas
+	// +optional
+	ReplicaServiceTemplate ofst.ServiceTemplateSpec `json:"replicaServiceTemplate,omitempty"`
+
+	// updateStrategy indicates the StatefulSetUpdateStrategy that will be
+	// employed to update Pods in the StatefulSet when a revision is made to
+	// Template. RollingUpdate is carried out by the operator, restarting the
+	// replicas one at a time and the primary last, after a switchover.
+	// OnDelete leaves the pods to be deleted by the user.
+	UpdateStrategy apps.StatefulSetUpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// TerminationPolicy controls the delete operation for database
+	// +optional
+	TerminationPolicy TerminationPolicy `json:"terminationPolicy,omitempty"`
+}
+
+type PostgresTLSConfig struct {
+	// CASecret refers to a secret with the tls.crt and tls.key of the CA, that signs the certificates
+	// of the members and clients. If not set, the operator creates a CA in secret <name>-ca.
+	// +optional
+	CASecret *core.LocalObjectReference `json:"caSecret,omitempty"`
+
+	// RequireSSL rejects remote connections, that are not encrypted.
+	// Replication connections must present the replication client certificate as well.
+	// +optional
+	RequireSSL bool `json:"requireSSL,omitempty"`
+}
+
+type PostgresClientAuthenticationSpec struct {
+	// Rules are an allow-list of connections, matched in order after the entries that the operator
+	// needs for replication, the exporter and the superuser. Remote connections that match none of
+	// them are rejected. Without rules, remote connections are authenticated with md5 passwords.
+	// +optional
+	Rules []PostgresHBARule `json:"rules,omitempty"`
+
+	// LocalSuperuser restricts logins of the superuser to the members themselves. The operator can
+	// then not manage PostgresDatabase and PostgresRole objects or report parameters pending a restart,
+	// and a former primary rejoins with a base backup instead of pg_rewind.
+	// +optional
+	LocalSuperuser bool `json:"localSuperuser,omitempty"`
+}
+
+type PostgresHBAType string
+
+const (
+	PostgresHBATypeLocal     PostgresHBAType = "local"
+	PostgresHBATypeHost      PostgresHBAType = "host"
+	PostgresHBATypeHostSSL   PostgresHBAType = "hostssl"
+	PostgresHBATypeHostNoSSL PostgresHBAType = "hostnossl"
+)
+
+// PostgresHBARule is an entry of pg_hba.conf
+type PostgresHBARule struct {
+	// Type of connections matched: local, host, hostssl or hostnossl
+	Type PostgresHBAType `json:"type"`
+	// Database matched, eg. all, sameuser, replication, a comma separated list of names or a +group
+	Database string `json:"database"`
+	// User matched, eg. all, a comma separated list of names or a +group
+	User string `json:"user"`
+	// Address matched in CIDR notation, or all, samehost or samenet. Not used for local connections.
+	// +optional
+	Address string `json:"address,omitempty"`
+	// Method to authenticate with, eg. trust, reject, md5, password, scram-sha-256, cert, peer or ldap
+	Method string `json:"method"`
+	// Options of the method, as name=value
+	// +optional
+	Options []string `json:"options,omitempty"`
+}
+
+type PostgresPasswordRotationSpec struct {
+	// Schedule in cron format, at which the password of the database superuser is rotated.
+	// A rotation can be requested at any time with the rotate-password annotation as well.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// GracePeriod for which the previous credentials stay valid. A rotation moves the credentials to the other
+	// login role of the pair <user> and <user>_alt, and the previous role keeps its password until the grace
+	// period has passed. The previous credentials are kept in the database secret as POSTGRES_USER_PREVIOUS and
+	// POSTGRES_PASSWORD_PREVIOUS meanwhile. Defaults to 1h.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+type PostgresArchiverSpec struct {
+	Storage *store.Backend `json:"storage,omitempty"`
+	// wal_keep_segments
+
+	// BaseBackup pushes base backups to the archive on a schedule, that spec.init.postgresWAL restores from
+	// +optional
+	BaseBackup *PostgresBaseBackupSpec `json:"baseBackup,omitempty"`
+
+	// Retention prunes the base backups and WAL segments from the archive, that are not needed anymore to
+	// recover to any retained point in time
+	// +optional
+	Retention *PostgresArchiveRetentionSpec `json:"retention,omitempty"`
+
+	// MaximumPendingWALFiles is the number of WAL files waiting on the primary to be archived, above which
+	// archiving is reported as falling behind. Defaults to 16.
+	// +optional
+	MaximumPendingWALFiles int32 `json:"maximumPendingWALFiles,omitempty"`
+
+	// Encryption encrypts the base backups and WAL segments, before they are written to the storage
+	// +optional
+	Encryption *BackupEncryptionSpec `json:"encryption,omitempty"`
+}
+
+type PostgresArchiveRetentionSpec struct {
+	// FullBackups is the number of the latest full base backups, that are kept
+	// +optional
+	FullBackups int32 `json:"fullBackups,omitempty"`
+	// Days of recoverability that are kept. The latest full base backup before that is kept too,
+	// to recover to any point in time within.
+	// +optional
+	Days int32 `json:"days,omitempty"`
+	// DryRun reports in status what would be pruned, without removing it from the archive
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+type PostgresBaseBackupSpec struct {
+	// Schedule of the base backups in cron format, eg. "0 2 * * *"
+	Schedule string `json:"schedule"`
+	// Retain is the number of full base backups that are kept. Older base backups and the WAL that only they need
+	// are deleted, after a base backup has been pushed. All base backups are kept, if it is 0.
+	// It must not be set together with spec.archiver.retention.
+	// +optional
+	Retain int32 `json:"retain,omitempty"`
+}
+
+type PostgresStatus struct {
+	Phase  DatabasePhase `json:"phase,omitempty"`
+	Reason string        `json:"reason,omitempty"`
+	// observedGeneration is the most recent generation observed for this resource. It corresponds to the
+	// resource's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration *types.IntHash `json:"observedGeneration,omitempty"`
+	// Fencing is the outcome of the last attempt to fence a previous primary
+	// before a new one was promoted
+	// +optional
+	Fencing *PostgresFencingStatus `json:"fencing,omitempty"`
+	// Switchover is the progress of the last planned switchover of the primary
+	// +optional
+	Switchover *PostgresSwitchoverStatus `json:"switchover,omitempty"`
+	// SynchronousStandbys are the replicas that the primary currently waits for
+	// to confirm commits
+	// +optional
+	SynchronousStandbys []string `json:"synchronousStandbys,omitempty"`
+	// Primary is the member that currently runs as primary
+	// +optional
+	Primary string `json:"primary,omitempty"`
+	// ReadyMembers is the number of members that are ready
+	// +optional
+	ReadyMembers int32 `json:"readyMembers,omitempty"`
+	// Members are the pods of the Postgres database with their role and replication state
+	// +optional
+	Members []PostgresMemberStatus `json:"members,omitempty"`
+	// Conditions are the latest observations of the state of the Postgres database
+	// +optional
+	Conditions []PostgresCondition `json:"conditions,omitempty"`
+	// Upgrade is the progress of the last major version upgrade
+	// +optional
+	Upgrade *PostgresUpgradeStatus `json:"upgrade,omitempty"`
+	// Rollout is the progress of the last rolling restart of the members
+	// +optional
+	Rollout *PostgresRolloutStatus `json:"rollout,omitempty"`
+	// VolumeExpansion is the progress of the last expansion of the data volumes
+	// +optional
+	VolumeExpansion *PostgresVolumeExpansionStatus `json:"volumeExpansion,omitempty"`
+	// PasswordRotation is the progress of the last rotation of the superuser password
+	// +optional
+	PasswordRotation *PostgresPasswordRotationStatus `json:"passwordRotation,omitempty"`
+	// PendingRestart lists the parameters, that are changed on the primary but take effect only after a restart
+	// +optional
+	PendingRestart []string `json:"pendingRestart,omitempty"`
+	// Clone is the progress of copying the data of the Postgres in spec.init.postgresClone
+	// +optional
+	Clone *PostgresCloneStatus `json:"clone,omitempty"`
+	// BaseBackup is the progress of the last scheduled base backup, with the base backups in the archive
+	// +optional
+	BaseBackup *PostgresBaseBackupStatus `json:"baseBackup,omitempty"`
+	// Archive is the state of the WAL archive in spec.archiver.storage
+	// +optional
+	Archive *PostgresArchiveStatus `json:"archive,omitempty"`
+}
+
+type PostgresMemberStatus struct {
+	// Name of the pod
+	Name string `json:"name"`
+	// Role is either primary or replica
+	Role  string `json:"role,omitempty"`
+	Ready bool   `json:"ready"`
+	// LSN is the latest WAL position of the member
+	// +optional
+	LSN string `json:"lsn,omitempty"`
+	// LagBytes is the amount of WAL in bytes that a replica is behind the primary
+	// +optional
+	LagBytes *int64 `json:"lagBytes,omitempty"`
+	// LagSeconds is the time in second that the replay of a replica is behind the primary
+	// +optional
+	LagSeconds *int64 `json:"lagSeconds,omitempty"`
+	// Timeline that the member is on
+	// +optional
+	Timeline int32 `json:"timeline,omitempty"`
+	// LastTransitionTime is the last time the role or readiness of the member changed
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+type PostgresConditionType string
+
+const (
+	// used when the primary is available and every member is ready
+	PostgresConditionReady PostgresConditionType = "Ready"
+	// used when exactly one member runs as primary and it is ready
+	PostgresConditionPrimaryAvailable PostgresConditionType = "PrimaryAvailable"
+	// used when every replica is ready and within maximumLagBeforeFailover of the primary
+	PostgresConditionReplicasInSync PostgresConditionType = "ReplicasInSync"
+	// used when the primary archives WAL files without failures
+	PostgresConditionArchiving PostgresConditionType = "Archiving"
+)
+
+type PostgresCondition struct {
+	Type   PostgresConditionType `json:"type"`
+	Status core.ConditionStatus  `json:"status"`
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+type FencingPhase string
+
+const (
+	// used when the previous primary is confirmed to be stopped
+	FencingPhaseSucceeded FencingPhase = "Succeeded"
+	// used when the previous primary could not be confirmed to be stopped within the deadline
+	FencingPhaseFailed FencingPhase = "Failed"
+)
+
+type PostgresFencingStatus struct {
+	// Leader is the newly elected member
+	Leader string `json:"leader"`
+	// Members that held the leadership before Leader
+	Members []string     `json:"members,omitempty"`
+	Phase   FencingPhase `json:"phase"`
+	Reason  string       `json:"reason,omitempty"`
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+type SwitchoverPhase string
+
+const (
+	// used when the switchover is accepted, but the primary has not started the handover yet
+	SwitchoverPhasePending SwitchoverPhase = "Pending"
+	// used while the primary hands the leadership over to the target
+	SwitchoverPhaseRunning SwitchoverPhase = "Running"
+	// used when the target has been promoted to primary
+	SwitchoverPhaseSucceeded SwitchoverPhase = "Succeeded"
+	// used when the switchover is rejected or aborted
+	SwitchoverPhaseFailed SwitchoverPhase = "Failed"
+)
+
+type PostgresSwitchoverStatus struct {
+	// Target is the pod requested to become primary, or best-replica
+	// until the primary picks the replica
+	Target string `json:"target"`
+	// Primary is the pod that was primary when the switchover was requested
+	Primary string          `json:"primary,omitempty"`
+	Phase   SwitchoverPhase `json:"phase"`
+	Reason  string          `json:"reason,omitempty"`
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+type UpgradePhase string
+
+const (
+	// used while the safety snapshot of the database is taken
+	UpgradePhaseSnapshotting UpgradePhase = "Snapshotting"
+	// used while the primary is switched over to the first member, whose data directory is upgraded
+	UpgradePhaseSwitchingOver UpgradePhase = "SwitchingOver"
+	// used while the members are stopped
+	UpgradePhaseStopping UpgradePhase = "Stopping"
+	// used while pg_upgrade runs on the data directory of the primary, and the data directories of the
+	// replicas are put aside to be seeded again
+	UpgradePhaseUpgrading UpgradePhase = "Upgrading"
+	// used while the members are started with the new version
+	UpgradePhaseRollingOut UpgradePhase = "RollingOut"
+	// used when the members run with the new version
+	UpgradePhaseSucceeded UpgradePhase = "Succeeded"
+	// used while the old data directories are restored, after the upgrade has failed
+	UpgradePhaseRollingBack UpgradePhase = "RollingBack"
+	// used when the members run with the old version again
+	UpgradePhaseRolledBack UpgradePhase = "RolledBack"
+	// used when the upgrade is rejected, or failed and could not be rolled back
+	UpgradePhaseFailed UpgradePhase = "Failed"
+)
+
+type PostgresUpgradeStatus struct {
+	// FromVersion is the PostgresVersion that the data directories are upgraded from
+	FromVersion string `json:"fromVersion"`
+	// ToVersion is the PostgresVersion that the data directories are upgraded to
+	ToVersion string `json:"toVersion"`
+	// Primary is the member whose data directory is upgraded, it is the only member
+	// allowed to become primary while the upgrade is rolled out or back
+	// +optional
+	Primary string `json:"primary,omitempty"`
+	// Snapshot is the name of the safety snapshot taken before the upgrade
+	// +optional
+	Snapshot string       `json:"snapshot,omitempty"`
+	Phase    UpgradePhase `json:"phase"`
+	Reason   string       `json:"reason,omitempty"`
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+type RolloutPhase string
+
+const (
+	// used while the members are restarted with the update revision
+	RolloutPhaseRunning RolloutPhase = "Running"
+	// used when every member runs with the update revision
+	RolloutPhaseSucceeded RolloutPhase = "Succeeded"
+	// used when a restarted member did not become ready, the rollout is halted until the next revision
+	RolloutPhaseFailed RolloutPhase = "Failed"
+)
+
+type PostgresRolloutStatus struct {
+	// Revision is the StatefulSet revision that the members are restarted with
+	Revision string `json:"revision"`
+	// Member is the pod that is restarted, or switched over from, currently
+	// +optional
+	Member string       `json:"member,omitempty"`
+	Phase  RolloutPhase `json:"phase"`
+	Reason string       `json:"reason,omitempty"`
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+type VolumeExpansionPhase string
+
+const (
+	// used while the volume claims of the members are expanded, and their file systems are resized
+	VolumeExpansionPhaseExpanding VolumeExpansionPhase = "Expanding"
+	// used while the StatefulSet is recreated with the expanded volume claim template
+	VolumeExpansionPhaseRecreating VolumeExpansionPhase = "RecreatingStatefulSet"
+	// used when the volumes and the StatefulSet have the requested size
+	VolumeExpansionPhaseSucceeded VolumeExpansionPhase = "Succeeded"
+	// used when a volume can not be expanded, as its StorageClass does not allow volume expansion
+	VolumeExpansionPhaseFailed VolumeExpansionPhase = "Failed"
+)
+
+type PostgresVolumeExpansionStatus struct {
+	// Size is the storage request that the data volumes are expanded to
+	Size resource.Quantity `json:"size"`
+	// PendingVolumes are the volume claims whose volume or file system is not resized yet
+	// +optional
+	PendingVolumes []string             `json:"pendingVolumes,omitempty"`
+	Phase          VolumeExpansionPhase `json:"phase"`
+	Reason         string               `json:"reason,omitempty"`
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+type PasswordRotationPhase string
+
+const (
+	// used while the new password waits to be set on the primary
+	PasswordRotationPhasePending PasswordRotationPhase = "Pending"
+	// used once the new password is set on the primary, until it is stored in the database secret
+	PasswordRotationPhaseApplied PasswordRotationPhase = "Applied"
+	// used once the new password is stored in the database secret, and the members are restarted with it
+	PasswordRotationPhaseSucceeded PasswordRotationPhase = "Succeeded"
+	// used when the new password could not be set, the previous password stays in use
+	PasswordRotationPhaseFailed PasswordRotationPhase = "Failed"
+)
+
+type PostgresPasswordRotationStatus struct {
+	Phase  PasswordRotationPhase `json:"phase"`
+	Reason string                `json:"reason,omitempty"`
+	// LastRotationTime is the time that the password has last been rotated successfully
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+	// PreviousPasswordExpiry is the time that the previous credentials expire in the database, and are
+	// removed from the database secret at. It is unset once they are removed.
+	// +optional
+	PreviousPasswordExpiry *metav1.Time `json:"previousPasswordExpiry,omitempty"`
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type PostgresList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Items is a list of Postgres CRD objects
+	Items []Postgres `json:"items,omitempty"`
+}
+
+type PostgresWALSourceSpec struct {
+	BackupName    string          `json:"backupName,omitempty"`
+	PITR          *RecoveryTarget `json:"pitr,omitempty"`
+	store.Backend `json:",inline,omitempty"`
+	// Encryption is the encryption of the archive. It is decrypted with every key of the secret.
+	// +optional
+	Encryption *BackupEncryptionSpec `json:"encryption,omitempty"`
+}
+
+type BaseBackupPhase string
+
+const (
+	// used while the base backup waits to be started by the member
+	BaseBackupPhasePending BaseBackupPhase = "Pending"
+	// used while the member pushes the base backup
+	BaseBackupPhaseRunning BaseBackupPhase = "Running"
+	// used once the base backup is pushed, and older base backups are deleted by spec.archiver.baseBackup.retain
+	BaseBackupPhaseSucceeded BaseBackupPhase = "Succeeded"
+	// used when the base backup could not be pushed. It is retried at the next scheduled time.
+	BaseBackupPhaseFailed BaseBackupPhase = "Failed"
+)
+
+type PostgresBaseBackupStatus struct {
+	Phase  BaseBackupPhase `json:"phase"`
+	Reason string          `json:"reason,omitempty"`
+	// Member is the pod that pushes the base backup, a replica if one is ready
+	// +optional
+	Member string `json:"member,omitempty"`
+	// LastBackupTime is the time that a base backup has last been pushed successfully
+	// +optional
+	LastBackupTime *metav1.Time `json:"lastBackupTime,omitempty"`
+	// Backups are the names of the base backups in the archive, oldest first.
+	// They can be restored with spec.init.postgresWAL.backupName.
+	// +optional
+	Backups []string `json:"backups,omitempty"`
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+type PostgresArchiveStatus struct {
+	// LastPruneTime is the last time the archive was pruned by spec.archiver.retention
+	// +optional
+	LastPruneTime *metav1.Time `json:"lastPruneTime,omitempty"`
+	// OldestBackup is the oldest base backup, that is retained by spec.archiver.retention
+	// +optional
+	OldestBackup string `json:"oldestBackup,omitempty"`
+	// PrunedBackups are the base backups, that the last pruning has removed.
+	// In dry-run mode, these are the base backups that would be removed.
+	// +optional
+	PrunedBackups []string `json:"prunedBackups,omitempty"`
+	// PrunedWALSegments is the number of WAL segments, that the last pruning has removed or would remove
+	// +optional
+	PrunedWALSegments int64 `json:"prunedWALSegments,omitempty"`
+	// DryRun is true, if the last pruning only reported what would be removed
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+	// Reason is why the last pruning failed
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// LastArchivedWAL is the last WAL file that the primary has archived
+	// +optional
+	LastArchivedWAL string `json:"lastArchivedWAL,omitempty"`
+	// LastArchivedTime is the time that the primary has last archived a WAL file
+	// +optional
+	LastArchivedTime *metav1.Time `json:"lastArchivedTime,omitempty"`
+	// FailedCount is the number of failed attempts of the primary to archive a WAL file
+	// +optional
+	FailedCount int64 `json:"failedCount,omitempty"`
+	// LastFailedWAL is the last WAL file that the primary has failed to archive
+	// +optional
+	LastFailedWAL string `json:"lastFailedWAL,omitempty"`
+	// LastFailedTime is the time that the primary has last failed to archive a WAL file
+	// +optional
+	LastFailedTime *metav1.Time `json:"lastFailedTime,omitempty"`
+	// LastError is the output of the last failed attempt to archive a WAL file.
+	// It is cleared, once a WAL file is archived again.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+	// PendingWALFiles is the number of WAL files waiting on the primary to be archived
+	// +optional
+	PendingWALFiles int64 `json:"pendingWALFiles,omitempty"`
+	// EarliestRecoveryTime is the earliest point in time, that can be recovered from the archive.
+	// It is the end of the oldest complete full base backup.
+	// +optional
+	EarliestRecoveryTime *metav1.Time `json:"earliestRecoveryTime,omitempty"`
+	// LatestRecoveryTime is the latest point in time, that can be recovered from the archive.
+	// It is the time that the latest WAL segment was stored.
+	// +optional
+	LatestRecoveryTime *metav1.Time `json:"latestRecoveryTime,omitempty"`
+}
+
+type PostgresCloneMethod string
+
+const (
+	// used to stream the data directory with pg_basebackup, from a replica of the source if it has one
+	PostgresCloneMethodBaseBackup PostgresCloneMethod = "BaseBackup"
+	// used to restore the latest base backup and the WAL archived by the source, without connecting to it
+	PostgresCloneMethodArchive PostgresCloneMethod = "Archive"
+)
+
+type PostgresCloneSourceSpec struct {
+	// Namespace of the source Postgres. Defaults to the namespace of the new Postgres.
+	// The user creating the new Postgres needs permission to get the source Postgres and its database secret.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the source Postgres. With the Archive method, it may also be the DormantDatabase
+	// of a deleted Postgres, whose archive is kept.
+	Name string `json:"name"`
+	// Method to copy the data with. Defaults to Archive, if the source archives WAL to a cloud storage
+	// or pitr is set, and to BaseBackup otherwise.
+	// +optional
+	Method PostgresCloneMethod `json:"method,omitempty"`
+	// PITR recovers the archive of the source up to the target, instead of all archived WAL.
+	// It requires the Archive method. The target must lie inside the recoverability window of the archive,
+	// so only targetTime and targetLSN are supported.
+	// +optional
+	PITR *RecoveryTarget `json:"pitr,omitempty"`
+}
+
+type PostgresClonePhase string
+
+const (
+	// used while the data of the source is copied
+	PostgresClonePhaseRunning PostgresClonePhase = "Running"
+	// used once the new Postgres has started with the copied data
+	PostgresClonePhaseSucceeded PostgresClonePhase = "Succeeded"
+	// used when the data can not be copied from the source, eg. as it does not exist
+	PostgresClonePhaseFailed PostgresClonePhase = "Failed"
+)
+
+type PostgresCloneStatus struct {
+	// Method that the data is copied with
+	Method PostgresCloneMethod `json:"method,omitempty"`
+	Phase  PostgresClonePhase  `json:"phase"`
+	Reason string              `json:"reason,omitempty"`
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+type RecoveryTarget struct {
+	// TargetTime specifies the time stamp up to which recovery will proceed.
+	TargetTime string `json:"targetTime,omitempty"`
+	// TargetTimeline specifies recovering into a particular timeline.
+	// The default is to recover along the same timeline that was current when the base backup was taken.
+	TargetTimeline string `json:"targetTimeline,omitempty"`
+	// TargetXID specifies the transaction ID up to which recovery will proceed.
+	TargetXID string `json:"targetXID,omitempty"`
+	// TargetLSN specifies the WAL position up to which recovery will proceed, eg. "0/3000060".
+	// It is supported since postgres 10.
+	TargetLSN string `json:"targetLSN,omitempty"`
+	// TargetName specifies the named restore point, created with pg_create_restore_point(),
+	// up to which recovery will proceed.
+	TargetName string `json:"targetName,omitempty"`
+	// TargetInclusive specifies whether to include ongoing transaction in given target point.
+	TargetInclusive *bool `json:"targetInclusive,omitempty"`
+}
+
+type PostgresStandbyMode string
+
+const (
+	HotPostgresStandbyMode  PostgresStandbyMode = "Hot"
+	WarmPostgresStandbyMode PostgresStandbyMode = "Warm"
+
+	// Deprecated
+	DeprecatedHotStandby PostgresStandbyMode = "hot"
+	// Deprecated
+	DeprecatedWarmStandby PostgresStandbyMode = "warm"
+)
+
+type PostgresStreamingMode string
+
+const (
+	SynchronousPostgresStreamingMode  PostgresStreamingMode = "Synchronous"
+	AsynchronousPostgresStreamingMode PostgresStreamingMode = "Asynchronous"
+
+	// Deprecated
+	DeprecatedAsynchronousStreaming PostgresStreamingMode = "asynchronous"
+)
+
+type SynchronousReplicationPolicy string
+
+const (
+	// used to keep writes waiting until the required number of synchronous standbys is available
+	SynchronousReplicationPolicyStrict SynchronousReplicationPolicy = "Strict"
+	// used to let writes continue with fewer synchronous standbys, down to asynchronous replication,
+	// while not enough replicas are healthy and caught up
+	SynchronousReplicationPolicyDegradeToAsync SynchronousReplicationPolicy = "DegradeToAsync"
+)
+
+type PostgresSynchronousReplication struct {
+	// SynchronousStandbys is the number of replicas that have to confirm a commit. Default 1
+	// +optional
+	SynchronousStandbys int32 `json:"synchronousStandbys,omitempty"`
+	// Policy decides whether writes wait or degrade to asynchronous replication, while fewer
+	// healthy, caught up replicas than SynchronousStandbys are available. Default Strict
+	// +optional
+	Policy SynchronousReplicationPolicy `json:"policy,omitempty"`
+}
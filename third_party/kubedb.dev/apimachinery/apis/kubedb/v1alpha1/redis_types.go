@@ -0,0 +1,115 @@
+package v1alpha1
+
+import (
+	"github.com/appscode/go/encoding/json/types"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	mona "kmodules.xyz/monitoring-agent-api/api/v1"
+	ofst "kmodules.xyz/offshoot-api/api/v1"
+)
+
+const (
+	ResourceCodeRedis     = "rd"
+	ResourceKindRedis     = "Redis"
+	ResourceSingularRedis = "redis"
+	ResourcePluralRedis   = "redises"
+)
+
+type RedisMode string
+
+const (
+	RedisModeStandalone RedisMode = "Standalone"
+	RedisModeCluster    RedisMode = "Cluster"
+)
+
+// Redis defines a Redis database.
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=redises,singular=redis,shortName=rd,categories={datastore,kubedb,appscode,all}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type Redis struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              RedisSpec   `json:"spec,omitempty"`
+	Status            RedisStatus `json:"status,omitempty"`
+}
+
+type RedisSpec struct {
+	// Version of Redis to be deployed.
+	Version types.StrYo `json:"version"`
+
+	// Number of instances to deploy for a MySQL database.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Default is "Standalone". If set to "Cluster", ClusterSpec is required and redis servers will
+	// start in cluster mode
+	Mode RedisMode `json:"mode,omitempty"`
+
+	// Redis cluster configuration for running redis servers in cluster mode. Required if Mode is set to "Cluster"
+	Cluster *RedisClusterSpec `json:"cluster,omitempty"`
+
+	// StorageType can be durable (default) or ephemeral
+	StorageType StorageType `json:"storageType,omitempty"`
+
+	// Storage spec to specify how storage shall be used.
+	Storage *core.PersistentVolumeClaimSpec `json:"storage,omitempty"`
+
+	// Monitor is used monitor database instance
+	// +optional
+	Monitor *mona.AgentSpec `json:"monitor,omitempty"`
+
+	// ConfigSource is an optional field to provide custom configuration file for database (i.e redis.conf).
+	// If specified, this file will be used as configuration file otherwise default configuration file will be used.
+	ConfigSource *core.VolumeSource `json:"configSource,omitempty"`
+
+	// PodTemplate is an optional configuration for pods used to expose database
+	// +optional
+	PodTemplate ofst.PodTemplateSpec `json:"podTemplate,omitempty"`
+
+	// ServiceTemplate is an optional configuration for service used to expose database
+	// +optional
+	ServiceTemplate ofst.ServiceTemplateSpec `json:"serviceTemplate,omitempty"`
+
+	// updateStrategy indicates the StatefulSetUpdateStrategy that will be
+	// employed to update Pods in the StatefulSet when a revision is made to
+	// Template.
+	UpdateStrategy apps.StatefulSetUpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// TerminationPolicy controls the delete operation for database
+	// +optional
+	TerminationPolicy TerminationPolicy `json:"terminationPolicy,omitempty"`
+}
+
+type RedisClusterSpec struct {
+	// Number of master nodes. It must be >= 3. If not specified, defaults to 3.
+	Master *int32 `json:"master,omitempty"`
+
+	// Number of replica(s) per master node. If not specified, defaults to 1.
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+type RedisStatus struct {
+	Phase  DatabasePhase `json:"phase,omitempty"`
+	Reason string        `json:"reason,omitempty"`
+	// observedGeneration is the most recent generation observed for this resource. It corresponds to the
+	// resource's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration *types.IntHash `json:"observedGeneration,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type RedisList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Items is a list of Redis TPR objects
+	Items []Redis `json:"items,omitempty"`
+}
@@ -0,0 +1,78 @@
+package v1alpha1
+
+import (
+	"kubedb.dev/apimachinery/apis/kubedb"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var SchemeGroupVersion = schema.GroupVersion{Group: kubedb.GroupName, Version: "v1alpha1"}
+
+var (
+	// TODO: move SchemeBuilder with zz_generated.deepcopy.go to k8s.io/api.
+	// localSchemeBuilder and AddToScheme will stay in k8s.io/kubernetes.
+	SchemeBuilder      runtime.SchemeBuilder
+	localSchemeBuilder = &SchemeBuilder
+	AddToScheme        = localSchemeBuilder.AddToScheme
+)
+
+func init() {
+	// We only register manually written functions here. The registration of the
+	// generated functions takes place in the generated files. The separation
+	// makes the code compile even when the generated files are missing.
+	localSchemeBuilder.Register(addKnownTypes)
+}
+
+// Kind takes an unqualified kind and returns a Group qualified GroupKind
+func Kind(kind string) schema.GroupKind {
+	return SchemeGroupVersion.WithKind(kind).GroupKind()
+}
+
+// Resource takes an unqualified resource and returns a Group qualified GroupResource
+func Resource(resource string) schema.GroupResource {
+	return SchemeGroupVersion.WithResource(resource).GroupResource()
+}
+
+// Adds the list of known types to api.Scheme.
+func addKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(SchemeGroupVersion,
+		&DormantDatabase{},
+		&DormantDatabaseList{},
+		&Elasticsearch{},
+		&ElasticsearchList{},
+		&Etcd{},
+		&EtcdList{},
+		&MariaDB{},
+		&MariaDBList{},
+		&Memcached{},
+		&MemcachedList{},
+		&MongoDB{},
+		&MongoDBList{},
+		&MySQL{},
+		&MySQLList{},
+		&PerconaXtraDB{},
+		&PerconaXtraDBList{},
+		&PgBouncer{},
+		&PgBouncerList{},
+		&Postgres{},
+		&PostgresList{},
+		&PostgresDatabase{},
+		&PostgresDatabaseList{},
+		&PostgresRole{},
+		&PostgresRoleList{},
+		&ProxySQL{},
+		&ProxySQLList{},
+		&Redis{},
+		&RedisList{},
+		&SnapshotList{},
+		&Snapshot{},
+	)
+
+	scheme.AddKnownTypes(SchemeGroupVersion,
+		&metav1.Status{},
+	)
+	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
+	return nil
+}
@@ -0,0 +1,140 @@
+package v1alpha1
+
+import (
+	core "k8s.io/api/core/v1"
+	store "kmodules.xyz/objectstore-api/api/v1"
+	ofst "kmodules.xyz/offshoot-api/api/v1"
+)
+
+type InitSpec struct {
+	ScriptSource *ScriptSourceSpec `json:"scriptSource,omitempty"`
+	// Deprecated
+	SnapshotSource *SnapshotSourceSpec    `json:"snapshotSource,omitempty"`
+	PostgresWAL    *PostgresWALSourceSpec `json:"postgresWAL,omitempty"`
+	// PostgresClone copies the data of another Postgres, while it keeps running
+	PostgresClone *PostgresCloneSourceSpec `json:"postgresClone,omitempty"`
+	// Name of stash restoreSession in same namespace of kubedb object.
+	// ref: https://github.com/stashed/stash/blob/09af5d319bb5be889186965afb04045781d6f926/apis/stash/v1beta1/restore_session_types.go#L22
+	StashRestoreSession *core.LocalObjectReference `json:"stashRestoreSession,omitempty"`
+}
+
+type ScriptSourceSpec struct {
+	ScriptPath        string `json:"scriptPath,omitempty"`
+	core.VolumeSource `json:",inline,omitempty"`
+}
+
+type SnapshotSourceSpec struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Arguments to the restore job
+	Args []string `json:"args,omitempty"`
+}
+
+type BackupScheduleSpec struct {
+	CronExpression string `json:"cronExpression,omitempty"`
+
+	// Snapshot Spec
+	store.Backend `json:",inline"`
+
+	// StorageType can be durable or ephemeral.
+	// If not given, database storage type will be used.
+	// +optional
+	StorageType *StorageType `json:"storageType,omitempty"`
+
+	// PodTemplate is an optional configuration for pods used to take database snapshots
+	// +optional
+	PodTemplate ofst.PodTemplateSpec `json:"podTemplate,omitempty"`
+
+	// PodVolumeClaimSpec is used to specify temporary storage for backup/restore Job.
+	// If not given, database's PvcSpec will be used.
+	// If storageType is durable, then a PVC will be created using this PVCSpec.
+	// If storageType is ephemeral, then an empty directory will be created of size PvcSpec.Resources.Requests[core.ResourceStorage].
+	// +optional
+	PodVolumeClaimSpec *core.PersistentVolumeClaimSpec `json:"podVolumeClaimSpec,omitempty"`
+
+	// Encryption encrypts the scheduled snapshots, before they are written to the storage
+	// +optional
+	Encryption *BackupEncryptionSpec `json:"encryption,omitempty"`
+}
+
+// BackupEncryptionSpec encrypts backups on the client, with keys that are kept in a secret.
+// Every key of the secret is an ASCII-armored PGP private key without passphrase, named by its key id.
+type BackupEncryptionSpec struct {
+	// SecretName is the secret with the encryption keys, in the namespace of the database
+	SecretName string `json:"secretName"`
+	// KeyID is the key of the secret, that new backups are encrypted with. Backups are decrypted with any key
+	// of the secret, so a key is rotated by adding a new key and changing keyID. The older keys must be kept,
+	// as long as backups encrypted with them are restored. It is not used, where backups are only restored.
+	// +optional
+	KeyID string `json:"keyID,omitempty"`
+}
+
+// LeaderElectionConfig contains essential attributes of leader election.
+// ref: https://github.com/kubernetes/client-go/blob/6134db91200ea474868bc6775e62cc294a74c6c6/tools/leaderelection/leaderelection.go#L105-L114
+type LeaderElectionConfig struct {
+	// LeaseDuration is the duration in second that non-leader candidates will
+	// wait to force acquire leadership. This is measured against time of
+	// last observed ack. Default 15
+	LeaseDurationSeconds int32 `json:"leaseDurationSeconds"`
+	// RenewDeadline is the duration in second that the acting master will retry
+	// refreshing leadership before giving up. Normally, LeaseDuration * 2 / 3.
+	// Default 10
+	RenewDeadlineSeconds int32 `json:"renewDeadlineSeconds"`
+	// RetryPeriod is the duration in second the LeaderElector clients should wait
+	// between tries of actions. Normally, LeaseDuration / 3.
+	// Default 2
+	RetryPeriodSeconds int32 `json:"retryPeriodSeconds"`
+	// MaximumLagBeforeFailover is the maximum lag in bytes of WAL that a replica may have to
+	// campaign for leadership. A replica refuses to become primary while another healthy member
+	// is further ahead of it than this. 0 only lets replicas campaign, that have received all WAL
+	// of the healthy members. Default 32MiB
+	// +optional
+	MaximumLagBeforeFailover *int64 `json:"maximumLagBeforeFailover,omitempty"`
+	// FencingTimeoutSeconds is the duration in second that a newly elected leader waits
+	// for the previous primary to stop, before it gives up the promotion. Default 30
+	// +optional
+	FencingTimeoutSeconds int32 `json:"fencingTimeoutSeconds,omitempty"`
+	// HealthCheckPeriodSeconds is the duration in second between two health checks of the local
+	// postgres server. Default 10
+	// +optional
+	HealthCheckPeriodSeconds int32 `json:"healthCheckPeriodSeconds,omitempty"`
+	// HealthCheckFailureThreshold is the number of consecutive failed health checks, after which
+	// the primary gives up the leadership and the member is reported as not alive. Default 3
+	// +optional
+	HealthCheckFailureThreshold int32 `json:"healthCheckFailureThreshold,omitempty"`
+}
+
+type DatabasePhase string
+
+const (
+	// used for Databases that are currently running
+	DatabasePhaseRunning DatabasePhase = "Running"
+	// used for Databases that are currently creating
+	DatabasePhaseCreating DatabasePhase = "Creating"
+	// used for Databases that are currently initializing
+	DatabasePhaseInitializing DatabasePhase = "Initializing"
+	// used for Databases that are Failed
+	DatabasePhaseFailed DatabasePhase = "Failed"
+)
+
+type StorageType string
+
+const (
+	// default storage type and requires spec.storage to be configured
+	StorageTypeDurable StorageType = "Durable"
+	// Uses emptyDir as storage
+	StorageTypeEphemeral StorageType = "Ephemeral"
+)
+
+type TerminationPolicy string
+
+const (
+	// Pauses database into a DormantDatabase
+	TerminationPolicyPause TerminationPolicy = "Pause"
+	// Deletes database pods, service, pvcs but leave the snapshot data intact. This will not create a DormantDatabase.
+	TerminationPolicyDelete TerminationPolicy = "Delete"
+	// Deletes database pods, service, pvcs and snapshot data. This will not create a DormantDatabase.
+	TerminationPolicyWipeOut TerminationPolicy = "WipeOut"
+	// Rejects attempt to delete database using ValidationWebhook. This replaces spec.doNotPause = true
+	TerminationPolicyDoNotTerminate TerminationPolicy = "DoNotTerminate"
+)
@@ -0,0 +1,26 @@
+module kubedb.dev/apimachinery
+
+go 1.12
+
+require (
+	github.com/appscode/go v0.0.0-20191016085057-e186b6c94a3b
+	github.com/go-openapi/spec v0.19.0
+	github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b
+	github.com/json-iterator/go v1.1.6
+	github.com/orcaman/concurrent-map v0.0.0-20190314100340-2693aad1ed75
+	github.com/pkg/errors v0.8.1
+	github.com/robfig/cron/v3 v3.0.0
+	gomodules.xyz/stow v0.2.2
+	k8s.io/api v0.0.0-20190503110853-61630f889b3c
+	k8s.io/apiextensions-apiserver v0.0.0-20190516231611-bf6753f2aa24
+	k8s.io/apimachinery v0.0.0-20190508063446-a3da69d3723c
+	k8s.io/client-go v11.0.0+incompatible
+	k8s.io/kube-openapi v0.0.0-20190502190224-411b2483e503
+	kmodules.xyz/client-go v0.0.0-20191023042933-b12d1ccfaf57
+	kmodules.xyz/custom-resources v0.0.0-20190927035424-65fe358bb045
+	kmodules.xyz/monitoring-agent-api v0.0.0-20190808150221-601a4005b7f7
+	kmodules.xyz/objectstore-api v0.0.0-20191014210450-ac380fa650a3
+	kmodules.xyz/offshoot-api v0.0.0-20190901210649-de049192326c
+	kmodules.xyz/webhook-runtime v0.0.0-20190808145328-4186c470d56b
+	stash.appscode.dev/stash v0.9.0-rc.2
+)